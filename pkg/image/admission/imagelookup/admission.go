@@ -0,0 +1,106 @@
+// Package imagelookup implements an admission plugin that rewrites short image references in
+// a pod spec ("<namespace>/<stream>:<tag>" or "<stream>:<tag>") into the pinned digest pull
+// spec of the image currently tagged into that ImageStream, when the stream or tag opts in via
+// LookupPolicy.Local. This lets users reference image streams directly from a pod's image
+// field instead of pasting registry URLs.
+package imagelookup
+
+import (
+	"strings"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// imageStreamGetter is the subset of the ImageStream registry that Plugin needs to resolve a
+// local reference.
+type imageStreamGetter interface {
+	GetImageStream(ctx kapi.Context, name string) (*imageapi.ImageStream, error)
+}
+
+// Plugin rewrites container image references that name an ImageStream tag with
+// LookupPolicy.Local enabled into the tag's resolved digest pull spec.
+type Plugin struct {
+	streams imageStreamGetter
+}
+
+// NewPlugin creates a Plugin backed by the given ImageStream registry.
+func NewPlugin(streams imageStreamGetter) *Plugin {
+	return &Plugin{streams: streams}
+}
+
+// Admit resolves every container and init container image in the pod named by a that refers to
+// a local ImageStream tag with lookup enabled.
+func (p *Plugin) Admit(a admission.Attributes) error {
+	if a.GetResource() != "pods" || a.GetSubresource() != "" {
+		return nil
+	}
+	pod, ok := a.GetObject().(*kapi.Pod)
+	if !ok {
+		return nil
+	}
+
+	for i := range pod.Spec.Containers {
+		p.resolve(a.GetNamespace(), &pod.Spec.Containers[i].Image)
+	}
+	for i := range pod.Spec.InitContainers {
+		p.resolve(a.GetNamespace(), &pod.Spec.InitContainers[i].Image)
+	}
+	return nil
+}
+
+// resolve replaces *image in place if it names a local ImageStream tag with lookup enabled.
+func (p *Plugin) resolve(namespace string, image *string) {
+	streamNamespace, streamName, tag, ok := parseLocalReference(namespace, *image)
+	if !ok {
+		return
+	}
+
+	stream, err := p.streams.GetImageStream(kapi.WithNamespace(kapi.NewContext(), streamNamespace), streamName)
+	if err != nil {
+		return
+	}
+
+	local := stream.Spec.LookupPolicy.Local
+	for _, t := range stream.Spec.Tags {
+		if t.Name == tag {
+			local = local || t.LookupPolicy.Local
+			break
+		}
+	}
+	if !local {
+		return
+	}
+
+	for _, t := range stream.Status.Tags {
+		if t.Tag == tag && len(t.Items) > 0 {
+			*image = t.Items[0].DockerImageReference
+			return
+		}
+	}
+}
+
+// parseLocalReference recognizes "<stream>:<tag>" and "<namespace>/<stream>:<tag>" image
+// strings, which never contain a registry host or digest, and returns their parts. A leading
+// path segment that looks like a registry host (contains a "." or ":", or is "localhost") is
+// rejected rather than treated as a namespace, matching Docker's own reference parsing rules.
+func parseLocalReference(defaultNamespace, image string) (namespace, stream, tag string, ok bool) {
+	if strings.ContainsAny(image, "@") || strings.Count(image, "/") > 1 {
+		return "", "", "", false
+	}
+	namespace = defaultNamespace
+	ref := image
+	if idx := strings.Index(image, "/"); idx != -1 {
+		namespace, ref = image[:idx], image[idx+1:]
+		if namespace == "localhost" || strings.ContainsAny(namespace, ".:") {
+			return "", "", "", false
+		}
+	}
+	parts := strings.SplitN(ref, ":", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", "", false
+	}
+	return namespace, parts[0], parts[1], true
+}
@@ -0,0 +1,97 @@
+package imagelookup
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+type fakeStreams struct {
+	stream *imageapi.ImageStream
+}
+
+func (f *fakeStreams) GetImageStream(ctx kapi.Context, name string) (*imageapi.ImageStream, error) {
+	return f.stream, nil
+}
+
+type fakeAttributes struct {
+	pod *kapi.Pod
+}
+
+func (f fakeAttributes) GetNamespace() string              { return "default" }
+func (f fakeAttributes) GetResource() string               { return "pods" }
+func (f fakeAttributes) GetSubresource() string            { return "" }
+func (f fakeAttributes) GetObject() interface{}            { return f.pod }
+func (f fakeAttributes) GetKind() string                   { return "Pod" }
+func (f fakeAttributes) GetOperation() admission.Operation { return admission.Create }
+func (f fakeAttributes) GetName() string                   { return f.pod.Name }
+
+func streamWithLocalTag() *imageapi.ImageStream {
+	return &imageapi.ImageStream{
+		Spec: imageapi.ImageStreamSpec{
+			Tags: []imageapi.TagReference{{Name: "latest", LookupPolicy: imageapi.ImageLookupPolicy{Local: true}}},
+		},
+		Status: imageapi.ImageStreamStatus{
+			Tags: []imageapi.NamedTagEventList{{
+				Tag:   "latest",
+				Items: []imageapi.TagEvent{{DockerImageReference: "registry.example.com/default/app@sha256:abc"}},
+			}},
+		},
+	}
+}
+
+func TestAdmitResolvesLocalTag(t *testing.T) {
+	p := NewPlugin(&fakeStreams{stream: streamWithLocalTag()})
+	pod := &kapi.Pod{Spec: kapi.PodSpec{Containers: []kapi.Container{{Image: "app:latest"}}}}
+
+	if err := p.Admit(fakeAttributes{pod: pod}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pod.Spec.Containers[0].Image != "registry.example.com/default/app@sha256:abc" {
+		t.Fatalf("expected image to be resolved to digest pull spec, got %q", pod.Spec.Containers[0].Image)
+	}
+}
+
+func TestAdmitLeavesNonLocalTagUntouched(t *testing.T) {
+	stream := streamWithLocalTag()
+	stream.Spec.Tags[0].LookupPolicy.Local = false
+	p := NewPlugin(&fakeStreams{stream: stream})
+	pod := &kapi.Pod{Spec: kapi.PodSpec{Containers: []kapi.Container{{Image: "app:latest"}}}}
+
+	if err := p.Admit(fakeAttributes{pod: pod}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pod.Spec.Containers[0].Image != "app:latest" {
+		t.Fatalf("expected image to be left untouched, got %q", pod.Spec.Containers[0].Image)
+	}
+}
+
+func TestAdmitInheritsStreamLevelLocal(t *testing.T) {
+	stream := streamWithLocalTag()
+	stream.Spec.LookupPolicy.Local = true
+	stream.Spec.Tags[0].LookupPolicy.Local = false
+	p := NewPlugin(&fakeStreams{stream: stream})
+	pod := &kapi.Pod{Spec: kapi.PodSpec{Containers: []kapi.Container{{Image: "app:latest"}}}}
+
+	if err := p.Admit(fakeAttributes{pod: pod}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pod.Spec.Containers[0].Image != "registry.example.com/default/app@sha256:abc" {
+		t.Fatalf("expected stream-level LookupPolicy.Local to be inherited by a tag with no explicit opt-in, got %q", pod.Spec.Containers[0].Image)
+	}
+}
+
+func TestAdmitLeavesFullPullSpecUntouched(t *testing.T) {
+	p := NewPlugin(&fakeStreams{stream: streamWithLocalTag()})
+	pod := &kapi.Pod{Spec: kapi.PodSpec{Containers: []kapi.Container{{Image: "registry.example.com/app:latest"}}}}
+
+	if err := p.Admit(fakeAttributes{pod: pod}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pod.Spec.Containers[0].Image != "registry.example.com/app:latest" {
+		t.Fatalf("expected full registry pull spec to be left untouched, got %q", pod.Spec.Containers[0].Image)
+	}
+}
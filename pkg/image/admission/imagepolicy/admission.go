@@ -0,0 +1,222 @@
+// Package imagepolicy implements an admission plugin that rejects pods referencing images
+// whose signatures do not satisfy the cluster's ImageSignaturePolicy rules.
+package imagepolicy
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// policyLister returns every ImageSignaturePolicy that could apply to namespace, which is the
+// cluster-wide policies plus any policy scoped to namespace.
+type policyLister interface {
+	ListImageSignaturePolicies(namespace string) ([]imageapi.ImageSignaturePolicy, error)
+}
+
+// imageResolver resolves the pull spec of a container to the Image it names.
+type imageResolver interface {
+	ResolveImage(dockerImageReference string) (*imageapi.Image, error)
+}
+
+// imageSignatureUpdater records the outcome of a trust evaluation back onto the Image's
+// signatures so users can see why an image was rejected.
+type imageSignatureUpdater interface {
+	UpdateImageSignature(image *imageapi.Image, signature *imageapi.ImageSignature) error
+}
+
+// Plugin rejects pods whose container images do not satisfy the ImageSignaturePolicy rules
+// that apply to the pod's namespace.
+type Plugin struct {
+	policies policyLister
+	images   imageResolver
+	updater  imageSignatureUpdater
+}
+
+// NewImageSignaturePolicyPlugin creates a Plugin backed by the given policy lister, image
+// resolver, and signature updater.
+func NewImageSignaturePolicyPlugin(policies policyLister, images imageResolver, updater imageSignatureUpdater) *Plugin {
+	return &Plugin{policies: policies, images: images, updater: updater}
+}
+
+// Admit rejects the pod in a if any of its container images fail to satisfy the first matching
+// ImageSignatureRule for the pod's namespace.
+func (p *Plugin) Admit(a admission.Attributes) error {
+	if a.GetResource() != "pods" || a.GetSubresource() != "" {
+		return nil
+	}
+	pod, ok := a.GetObject().(*kapi.Pod)
+	if !ok {
+		return nil
+	}
+
+	policies, err := p.policies.ListImageSignaturePolicies(a.GetNamespace())
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	rules := flattenRules(policies)
+
+	for _, c := range pod.Spec.Containers {
+		if err := p.admitImage(c.Image, rules); err != nil {
+			return admission.NewForbidden(a, err)
+		}
+	}
+	for _, c := range pod.Spec.InitContainers {
+		if err := p.admitImage(c.Image, rules); err != nil {
+			return admission.NewForbidden(a, err)
+		}
+	}
+	return nil
+}
+
+// admitImage checks ref against the first rule whose Pattern matches it.
+func (p *Plugin) admitImage(ref string, rules []imageapi.ImageSignatureRule) error {
+	rule, ok := matchingRule(ref, rules)
+	if !ok {
+		return fmt.Errorf("image %q does not match any image signature policy rule", ref)
+	}
+	if rule.Requirement == imageapi.Reject {
+		return fmt.Errorf("image %q is rejected by image signature policy rule %q", ref, rule.Pattern)
+	}
+
+	image, err := p.images.ResolveImage(ref)
+	if err != nil {
+		return err
+	}
+
+	for i := range image.Signatures {
+		signature := &image.Signatures[i]
+		if !signatureMatches(signature, rule.Match) {
+			continue
+		}
+		if !identityMatches(rule.Requirement, image.DockerImageReference, image.Name, signature.ImageIdentity) {
+			continue
+		}
+		if isTrusted(signature) {
+			return nil
+		}
+	}
+
+	rejection := imageapi.SignatureCondition{
+		Type:    imageapi.SignatureRejected,
+		Status:  kapi.ConditionTrue,
+		Reason:  "PolicyNotSatisfied",
+		Message: fmt.Sprintf("no signature satisfied image signature policy rule %q", rule.Pattern),
+	}
+	for i := range image.Signatures {
+		if p.updater != nil {
+			p.updater.UpdateImageSignature(image, addCondition(&image.Signatures[i], rejection))
+		}
+	}
+
+	return fmt.Errorf("image %q has no signature trusted by image signature policy rule %q", ref, rule.Pattern)
+}
+
+// matchingRule returns the first rule whose Pattern matches the registry/namespace/name portion
+// of ref, i.e. ref without its tag or digest.
+func matchingRule(ref string, rules []imageapi.ImageSignatureRule) (imageapi.ImageSignatureRule, bool) {
+	repo, _, _ := splitImageReference(ref)
+	for _, rule := range rules {
+		if ok, _ := path.Match(rule.Pattern, repo); ok {
+			return rule, true
+		}
+	}
+	return imageapi.ImageSignatureRule{}, false
+}
+
+// signatureMatches reports whether signature satisfies the issuer, key, and claim
+// restrictions in match.
+func signatureMatches(signature *imageapi.ImageSignature, match imageapi.SignatureMatch) bool {
+	if match.CommonName != "" && (signature.IssuedBy == nil || signature.IssuedBy.CommonName != match.CommonName) {
+		return false
+	}
+	if match.Organization != "" && (signature.IssuedBy == nil || signature.IssuedBy.Organization != match.Organization) {
+		return false
+	}
+	if len(match.PublicKeyIDs) > 0 {
+		if signature.IssuedTo == nil || !contains(match.PublicKeyIDs, signature.IssuedTo.PublicKeyID) {
+			return false
+		}
+	}
+	for k, v := range match.SignedClaims {
+		if signature.SignedClaims[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// identityMatches reports whether identity (an ImageSignature's ImageIdentity) satisfies
+// requirement against the *resolved* image's own resolvedRef and digest — never the pod's raw,
+// possibly tag-based image string, since a signature can only attest to the specific image it
+// was issued for. SignatureRequired only needs a trusted signature from a matching issuer, so
+// any identity satisfies it (Reject rules are refused before any signature is checked).
+// MatchExact requires identity to name the exact same reference as resolvedRef.
+// MatchRepoDigestOrExact additionally accepts an identity in the same repository pinned to the
+// image's actual digest, even if resolvedRef names the image by a tag, mirroring the
+// containers/image trust model's "matchRepoDigestOrExact".
+func identityMatches(requirement imageapi.SignatureRequirementType, resolvedRef, digest, identity string) bool {
+	switch requirement {
+	case imageapi.MatchExact:
+		return identity == resolvedRef
+	case imageapi.MatchRepoDigestOrExact:
+		if identity == resolvedRef {
+			return true
+		}
+		repo, _, _ := splitImageReference(resolvedRef)
+		identityRepo, identitySuffix, identityIsDigest := splitImageReference(identity)
+		return identityIsDigest && identityRepo == repo && identitySuffix == digest
+	default:
+		return true
+	}
+}
+
+// splitImageReference splits ref into its repository and its tag or digest suffix, reporting
+// whether the suffix is a digest (following an "@") rather than a tag (following the final ":").
+func splitImageReference(ref string) (repo, suffix string, isDigest bool) {
+	if idx := strings.Index(ref, "@"); idx != -1 {
+		return ref[:idx], ref[idx+1:], true
+	}
+	if idx := strings.LastIndex(ref, ":"); idx > strings.LastIndex(ref, "/") {
+		return ref[:idx], ref[idx+1:], false
+	}
+	return ref, "", false
+}
+
+// isTrusted reports whether signature carries a SignatureTrusted condition with status True.
+func isTrusted(signature *imageapi.ImageSignature) bool {
+	for _, c := range signature.Conditions {
+		if c.Type == imageapi.SignatureTrusted && c.Status == kapi.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// addCondition appends condition to signature's Conditions and returns signature.
+func addCondition(signature *imageapi.ImageSignature, condition imageapi.SignatureCondition) *imageapi.ImageSignature {
+	signature.Conditions = append(signature.Conditions, condition)
+	return signature
+}
+
+func flattenRules(policies []imageapi.ImageSignaturePolicy) []imageapi.ImageSignatureRule {
+	var rules []imageapi.ImageSignatureRule
+	for _, p := range policies {
+		rules = append(rules, p.Rules...)
+	}
+	return rules
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
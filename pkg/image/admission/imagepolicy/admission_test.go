@@ -0,0 +1,187 @@
+package imagepolicy
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+type fakePolicies struct {
+	rules []imageapi.ImageSignatureRule
+}
+
+func (f *fakePolicies) ListImageSignaturePolicies(namespace string) ([]imageapi.ImageSignaturePolicy, error) {
+	return []imageapi.ImageSignaturePolicy{{Rules: f.rules}}, nil
+}
+
+type fakeImages struct {
+	image *imageapi.Image
+}
+
+func (f *fakeImages) ResolveImage(ref string) (*imageapi.Image, error) {
+	return f.image, nil
+}
+
+func trustedImage() *imageapi.Image {
+	return &imageapi.Image{
+		ObjectMeta:           kapi.ObjectMeta{Name: "sha256:abc"},
+		DockerImageReference: "registry.example.com/app@sha256:abc",
+		Signatures: []imageapi.ImageSignature{{
+			IssuedBy:   &imageapi.SignatureIssuer{SignatureGenericEntity: imageapi.SignatureGenericEntity{CommonName: "trusted-ca"}},
+			Conditions: []imageapi.SignatureCondition{{Type: imageapi.SignatureTrusted, Status: kapi.ConditionTrue}},
+		}},
+	}
+}
+
+func untrustedImage() *imageapi.Image {
+	return &imageapi.Image{
+		Signatures: []imageapi.ImageSignature{{
+			IssuedBy: &imageapi.SignatureIssuer{SignatureGenericEntity: imageapi.SignatureGenericEntity{CommonName: "unknown"}},
+		}},
+	}
+}
+
+type fakeAttributes struct {
+	pod *kapi.Pod
+}
+
+func (f fakeAttributes) GetNamespace() string              { return "default" }
+func (f fakeAttributes) GetResource() string               { return "pods" }
+func (f fakeAttributes) GetSubresource() string            { return "" }
+func (f fakeAttributes) GetObject() interface{}            { return f.pod }
+func (f fakeAttributes) GetKind() string                   { return "Pod" }
+func (f fakeAttributes) GetOperation() admission.Operation { return admission.Create }
+func (f fakeAttributes) GetName() string                   { return f.pod.Name }
+
+func podWithImage(image string) *kapi.Pod {
+	return &kapi.Pod{Spec: kapi.PodSpec{Containers: []kapi.Container{{Image: image}}}}
+}
+
+func podWithInitImage(image string) *kapi.Pod {
+	return &kapi.Pod{Spec: kapi.PodSpec{InitContainers: []kapi.Container{{Image: image}}}}
+}
+
+func TestAdmitAllowsTrustedSignature(t *testing.T) {
+	p := NewImageSignaturePolicyPlugin(
+		&fakePolicies{rules: []imageapi.ImageSignatureRule{{
+			Pattern:     "registry.example.com/*",
+			Requirement: imageapi.SignatureRequired,
+			Match:       imageapi.SignatureMatch{CommonName: "trusted-ca"},
+		}}},
+		&fakeImages{image: trustedImage()},
+		nil,
+	)
+
+	err := p.Admit(fakeAttributes{pod: podWithImage("registry.example.com/app:latest")})
+	if err != nil {
+		t.Fatalf("expected trusted image to be admitted, got %v", err)
+	}
+}
+
+func TestAdmitRejectsUntrustedSignature(t *testing.T) {
+	p := NewImageSignaturePolicyPlugin(
+		&fakePolicies{rules: []imageapi.ImageSignatureRule{{
+			Pattern:     "registry.example.com/*",
+			Requirement: imageapi.SignatureRequired,
+			Match:       imageapi.SignatureMatch{CommonName: "trusted-ca"},
+		}}},
+		&fakeImages{image: untrustedImage()},
+		nil,
+	)
+
+	if err := p.Admit(fakeAttributes{pod: podWithImage("registry.example.com/app:latest")}); err == nil {
+		t.Fatalf("expected untrusted image to be rejected")
+	}
+}
+
+func TestAdmitRejectsMatchExactForDifferentIdentity(t *testing.T) {
+	image := trustedImage()
+	image.Signatures[0].ImageIdentity = "registry.example.com/app:other"
+	p := NewImageSignaturePolicyPlugin(
+		&fakePolicies{rules: []imageapi.ImageSignatureRule{{
+			Pattern:     "registry.example.com/*",
+			Requirement: imageapi.MatchExact,
+			Match:       imageapi.SignatureMatch{CommonName: "trusted-ca"},
+		}}},
+		&fakeImages{image: image},
+		nil,
+	)
+
+	if err := p.Admit(fakeAttributes{pod: podWithImage("registry.example.com/app:latest")}); err == nil {
+		t.Fatalf("expected image trusted for a different reference to be rejected")
+	}
+}
+
+func TestAdmitAllowsMatchExactForResolvedIdentity(t *testing.T) {
+	image := trustedImage()
+	image.Signatures[0].ImageIdentity = image.DockerImageReference
+	p := NewImageSignaturePolicyPlugin(
+		&fakePolicies{rules: []imageapi.ImageSignatureRule{{
+			Pattern:     "registry.example.com/*",
+			Requirement: imageapi.MatchExact,
+			Match:       imageapi.SignatureMatch{CommonName: "trusted-ca"},
+		}}},
+		&fakeImages{image: image},
+		nil,
+	)
+
+	if err := p.Admit(fakeAttributes{pod: podWithImage("registry.example.com/app:latest")}); err != nil {
+		t.Fatalf("expected signature trusted for the resolved reference to be admitted, got %v", err)
+	}
+}
+
+func TestAdmitAllowsMatchRepoDigestOrExactAcrossTags(t *testing.T) {
+	// The pod asks for "app:latest", which resolves to an image pulled by a different tag
+	// ("app:v2"), but the signature's identity is pinned to that image's actual digest. The
+	// rule must be satisfied by the shared repo+digest, not by any tag in either string.
+	image := trustedImage()
+	image.DockerImageReference = "registry.example.com/app:v2"
+	image.Signatures[0].ImageIdentity = "registry.example.com/app@sha256:abc"
+	p := NewImageSignaturePolicyPlugin(
+		&fakePolicies{rules: []imageapi.ImageSignatureRule{{
+			Pattern:     "registry.example.com/*",
+			Requirement: imageapi.MatchRepoDigestOrExact,
+			Match:       imageapi.SignatureMatch{CommonName: "trusted-ca"},
+		}}},
+		&fakeImages{image: image},
+		nil,
+	)
+
+	if err := p.Admit(fakeAttributes{pod: podWithImage("registry.example.com/app:latest")}); err != nil {
+		t.Fatalf("expected signature trusted for the same digest across tags to be admitted, got %v", err)
+	}
+}
+
+func TestAdmitChecksInitContainers(t *testing.T) {
+	p := NewImageSignaturePolicyPlugin(
+		&fakePolicies{rules: []imageapi.ImageSignatureRule{{
+			Pattern:     "registry.example.com/*",
+			Requirement: imageapi.SignatureRequired,
+			Match:       imageapi.SignatureMatch{CommonName: "trusted-ca"},
+		}}},
+		&fakeImages{image: untrustedImage()},
+		nil,
+	)
+
+	if err := p.Admit(fakeAttributes{pod: podWithInitImage("registry.example.com/app:latest")}); err == nil {
+		t.Fatalf("expected untrusted init container image to be rejected")
+	}
+}
+
+func TestAdmitRejectsMatchingRejectRule(t *testing.T) {
+	p := NewImageSignaturePolicyPlugin(
+		&fakePolicies{rules: []imageapi.ImageSignatureRule{{
+			Pattern:     "registry.untrusted.com/*",
+			Requirement: imageapi.Reject,
+		}}},
+		&fakeImages{},
+		nil,
+	)
+
+	if err := p.Admit(fakeAttributes{pod: podWithImage("registry.untrusted.com/app:latest")}); err == nil {
+		t.Fatalf("expected image matching a reject rule to be rejected")
+	}
+}
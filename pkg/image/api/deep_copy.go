@@ -0,0 +1,94 @@
+package api
+
+// DeepCopy returns a copy of the Image with its own DockerImageLayers, Signatures,
+// DockerImageSignatures and DockerImageManifests slices, including each manifest's OSFeatures
+// and Manifest. ObjectMeta, DockerImageMetadata, the signature blobs and the fields of each
+// ImageSignature are shared with in.
+func (in Image) DeepCopy() Image {
+	out := in
+	if in.DockerImageLayers != nil {
+		out.DockerImageLayers = append([]ImageLayer{}, in.DockerImageLayers...)
+	}
+	if in.Signatures != nil {
+		out.Signatures = append([]ImageSignature{}, in.Signatures...)
+	}
+	if in.DockerImageSignatures != nil {
+		out.DockerImageSignatures = append([][]byte{}, in.DockerImageSignatures...)
+	}
+	if in.DockerImageManifests != nil {
+		out.DockerImageManifests = make([]ImageManifest, len(in.DockerImageManifests))
+		for i, manifest := range in.DockerImageManifests {
+			out.DockerImageManifests[i] = manifest
+			if manifest.OSFeatures != nil {
+				out.DockerImageManifests[i].OSFeatures = append([]string{}, manifest.OSFeatures...)
+			}
+			if manifest.Manifest != nil {
+				out.DockerImageManifests[i].Manifest = append([]byte{}, manifest.Manifest...)
+			}
+		}
+	}
+	return out
+}
+
+// DeepCopy returns a copy of the ImageStream with its own Spec.Tags and Status.Tags slices.
+// ObjectMeta and the fields of each tag (annotations, From, history items, conditions) are shared
+// with in.
+func (in ImageStream) DeepCopy() ImageStream {
+	out := in
+	if in.Spec.Tags != nil {
+		out.Spec.Tags = append([]TagReference{}, in.Spec.Tags...)
+	}
+	if in.Status.Tags != nil {
+		out.Status.Tags = append([]NamedTagEventList{}, in.Status.Tags...)
+	}
+	return out
+}
+
+// DeepCopy returns a copy of the ImageStreamImport with its own spec and status slices and
+// pointers, and Status.Import copied by ImageStream.DeepCopy. ObjectMeta, each ImageImportSpec's
+// To and the Image each import status points at are shared with in.
+func (in ImageStreamImport) DeepCopy() ImageStreamImport {
+	out := in
+	if in.Spec.Repository != nil {
+		repository := *in.Spec.Repository
+		out.Spec.Repository = &repository
+	}
+	if in.Spec.Images != nil {
+		out.Spec.Images = append([]ImageImportSpec{}, in.Spec.Images...)
+	}
+	if in.Status.Import != nil {
+		imported := in.Status.Import.DeepCopy()
+		out.Status.Import = &imported
+	}
+	if in.Status.Repository != nil {
+		repository := *in.Status.Repository
+		if in.Status.Repository.Images != nil {
+			repository.Images = append([]ImageImportStatus{}, in.Status.Repository.Images...)
+		}
+		if in.Status.Repository.AdditionalTags != nil {
+			repository.AdditionalTags = append([]string{}, in.Status.Repository.AdditionalTags...)
+		}
+		out.Status.Repository = &repository
+	}
+	if in.Status.Images != nil {
+		out.Status.Images = append([]ImageImportStatus{}, in.Status.Images...)
+	}
+	return out
+}
+
+// AddTagEventToImageStream records image as the current value of tag on stream, pushing any
+// previous value for that tag down in its history.
+func AddTagEventToImageStream(stream *ImageStream, tag string, image Image) {
+	event := TagEvent{
+		DockerImageReference: image.DockerImageReference,
+		Image:                image.Name,
+	}
+	for i := range stream.Status.Tags {
+		if stream.Status.Tags[i].Tag != tag {
+			continue
+		}
+		stream.Status.Tags[i].Items = append([]TagEvent{event}, stream.Status.Tags[i].Items...)
+		return
+	}
+	stream.Status.Tags = append(stream.Status.Tags, NamedTagEventList{Tag: tag, Items: []TagEvent{event}})
+}
@@ -0,0 +1,394 @@
+package api
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// ImageList is a list of Image objects.
+type ImageList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+
+	Items []Image
+}
+
+// Image is an immutable representation of a Docker image and metadata at a point in time.
+type Image struct {
+	unversioned.TypeMeta
+	api.ObjectMeta
+
+	// The string that can be used to pull this image.
+	DockerImageReference string
+	// Metadata about this image
+	DockerImageMetadata runtime.RawExtension
+	// This attribute conveys the version of the object, which if empty defaults to "1.0"
+	DockerImageMetadataVersion string
+	// The raw JSON of the manifest
+	DockerImageManifest string
+	// DockerImageLayers represents the layers in the image. May not be set if the image does not define that data.
+	DockerImageLayers []ImageLayer
+	// Signatures holds all signatures of the image.
+	Signatures []ImageSignature
+	// DockerImageSignatures provides the signatures as opaque blobs. This is a part of manifest schema v1.
+	DockerImageSignatures [][]byte
+	// DockerImageManifestMediaType specifies the mediaType of manifest. This is a part of manifest schema v2.
+	DockerImageManifestMediaType string
+	// DockerImageConfig is a JSON blob that the runtime uses to set up the container. This is a part of manifest schema v2.
+	DockerImageConfig string
+	// DockerImageManifests holds information about sub-manifests of the image, used when the
+	// image is a manifest list that fans out to per-platform child images. May not be set if the
+	// image is not a manifest list.
+	DockerImageManifests []ImageManifest
+}
+
+// ImageManifest describes a single sub-manifest of a manifest list, usually a single
+// platform-specific image.
+type ImageManifest struct {
+	Digest       string
+	MediaType    string
+	Architecture string
+	OS           string
+	Variant      string
+	OSVersion    string
+	OSFeatures   []string
+	Size         int64
+	Manifest     []byte
+}
+
+const (
+	// ImageManifestParentAnnotation is set on an Image that was materialized from a single entry
+	// of another Image's DockerImageManifests, and holds the name of that parent manifest list
+	// Image.
+	ImageManifestParentAnnotation = "image.openshift.io/parentManifest"
+)
+
+// ImageLayer represents a single layer of the image. Some images may have multiple layers. Some may have none.
+type ImageLayer struct {
+	Name      string
+	Size      int64
+	MediaType string
+}
+
+// ImageSignature holds a signature of an image. See the versioned API for the full description of this type.
+type ImageSignature struct {
+	Type       string
+	Content    []byte
+	Conditions []SignatureCondition
+
+	ImageIdentity string
+	SignedClaims  map[string]string
+	Created       *unversioned.Time
+	IssuedBy      *SignatureIssuer
+	IssuedTo      *SignatureSubject
+}
+
+// SignatureConditionType is a type of image signature condition.
+type SignatureConditionType string
+
+// These are valid conditions of an image signature.
+const (
+	// SignatureTrusted means the signature has been matched against a policy rule and is
+	// considered trusted by the cluster's signature policy.
+	SignatureTrusted SignatureConditionType = "Trusted"
+	// SignatureRejected means the signature was evaluated against the cluster's signature
+	// policy and did not satisfy any matching rule.
+	SignatureRejected SignatureConditionType = "Rejected"
+)
+
+// SignatureCondition describes an image signature condition of particular kind at particular probe time.
+type SignatureCondition struct {
+	Type               SignatureConditionType
+	Status             api.ConditionStatus
+	LastProbeTime      unversioned.Time
+	LastTransitionTime unversioned.Time
+	Reason             string
+	Message            string
+}
+
+// SignatureGenericEntity holds a generic information about a person or entity who is an issuer or a subject
+// of signing certificate or key.
+type SignatureGenericEntity struct {
+	Organization string
+	CommonName   string
+}
+
+// SignatureIssuer holds information about an issuer of signing certificate or key.
+type SignatureIssuer struct {
+	SignatureGenericEntity
+}
+
+// SignatureSubject holds information about a person or entity who created the signature.
+type SignatureSubject struct {
+	SignatureGenericEntity
+	PublicKeyID string
+}
+
+// ImageStreamList is a list of ImageStream objects.
+type ImageStreamList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+
+	Items []ImageStream
+}
+
+// ImageStream stores a mapping of tags to images, metadata overrides that are applied
+// when images are tagged in a stream, and an optional reference to a Docker image
+// repository on a registry.
+type ImageStream struct {
+	unversioned.TypeMeta
+	api.ObjectMeta
+
+	Spec   ImageStreamSpec
+	Status ImageStreamStatus
+}
+
+// ImageStreamSpec represents options for ImageStreams.
+type ImageStreamSpec struct {
+	DockerImageRepository string
+	Tags                  []TagReference
+	LookupPolicy          ImageLookupPolicy
+}
+
+// ImageLookupPolicy describes how an image stream can be used to override the image references
+// used by pods in a namespace.
+type ImageLookupPolicy struct {
+	Local bool
+}
+
+// TagReference specifies optional annotations for images using this tag and an optional reference to an ImageStreamTag, ImageStreamImage, or DockerImage this tag should track.
+type TagReference struct {
+	Name         string
+	Annotations  map[string]string
+	From         *api.ObjectReference
+	Reference    bool
+	Generation   *int64
+	ImportPolicy TagImportPolicy
+	LookupPolicy ImageLookupPolicy
+}
+
+// TagImportPolicy controls how images are imported for a specific tag.
+type TagImportPolicy struct {
+	Insecure  bool
+	Scheduled bool
+}
+
+// ImageStreamStatus contains information about the state of this image stream.
+type ImageStreamStatus struct {
+	DockerImageRepository string
+	Tags                  []NamedTagEventList
+}
+
+// NamedTagEventList relates a tag to its image history.
+type NamedTagEventList struct {
+	Tag        string
+	Items      []TagEvent
+	Conditions []TagEventCondition
+}
+
+// TagEvent is used by ImageStreamStatus to keep a historical record of images associated with a tag.
+type TagEvent struct {
+	Created              unversioned.Time
+	DockerImageReference string
+	Image                string
+	Generation           int64
+}
+
+// TagEventConditionType is a type of tag event condition.
+type TagEventConditionType string
+
+// These are valid conditions of TagEvents.
+const (
+	ImportSuccess TagEventConditionType = "ImportSuccess"
+)
+
+// TagEventCondition contains condition information for a tag event.
+type TagEventCondition struct {
+	Type               TagEventConditionType
+	Status             api.ConditionStatus
+	LastTransitionTime unversioned.Time
+	Reason             string
+	Message            string
+	Generation         int64
+}
+
+// ImageStreamMapping represents a mapping from a single tag to a Docker image as
+// well as the reference to the Docker image repository the image came from.
+type ImageStreamMapping struct {
+	unversioned.TypeMeta
+	api.ObjectMeta
+
+	Image Image
+	Tag   string
+}
+
+// ImageStreamTag represents an Image that is retrieved by tag name from an ImageStream.
+type ImageStreamTag struct {
+	Image
+	ImageName string
+}
+
+// ImageStreamTagList is a list of ImageStreamTag objects.
+type ImageStreamTagList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+
+	Items []ImageStreamTag
+}
+
+// ImageStreamImage represents an Image that is retrieved by image name from an ImageStream.
+type ImageStreamImage struct {
+	Image
+	ImageName string
+}
+
+// DockerImageReference points to a Docker image.
+type DockerImageReference struct {
+	Registry  string
+	Namespace string
+	Name      string
+	Tag       string
+	ID        string
+}
+
+// ImageStreamImport is a request to import images from an external Docker registry. The result
+// of each requested image and repository is reported on status; the image stream is only
+// updated when spec.import is true.
+type ImageStreamImport struct {
+	unversioned.TypeMeta
+	api.ObjectMeta
+
+	Spec   ImageStreamImportSpec
+	Status ImageStreamImportStatus
+}
+
+// ImageStreamImportSpec defines what images should be imported.
+type ImageStreamImportSpec struct {
+	Import     bool
+	Repository *RepositoryImportSpec
+	Images     []ImageImportSpec
+}
+
+// ImageStreamImportStatus contains information about the status of the import.
+type ImageStreamImportStatus struct {
+	Import     *ImageStream
+	Repository *RepositoryImportStatus
+	Images     []ImageImportStatus
+}
+
+// RepositoryImportSpec describes a request to import images from a Docker image repository.
+type RepositoryImportSpec struct {
+	From            api.ObjectReference
+	ImportPolicy    TagImportPolicy
+	IncludeManifest bool
+}
+
+// RepositoryImportStatus describes the result of an image repository import.
+type RepositoryImportStatus struct {
+	Status         unversioned.Status
+	Images         []ImageImportStatus
+	AdditionalTags []string
+}
+
+// ImageImportSpec describes a request to import a specific image.
+type ImageImportSpec struct {
+	From            api.ObjectReference
+	To              *api.LocalObjectReference
+	ImportPolicy    TagImportPolicy
+	IncludeManifest bool
+}
+
+// ImageImportStatus describes the result of an image import.
+type ImageImportStatus struct {
+	Status unversioned.Status
+	Image  *Image
+	Tag    string
+}
+
+// ImageTag represents a single tag on an ImageStream, combining the spec and status of that
+// tag along with the resolved Image it currently points at, if any.
+type ImageTag struct {
+	unversioned.TypeMeta
+	api.ObjectMeta
+
+	Spec   *TagReference
+	Status *NamedTagEventList
+	Image  *Image
+}
+
+// ImageTagList is a list of ImageTag objects.
+type ImageTagList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+
+	Items []ImageTag
+}
+
+// ImageStreamLayers describes information about the layers and images associated with the
+// status tags of an image stream, without requiring a client to retrieve every referenced
+// Image object.
+type ImageStreamLayers struct {
+	unversioned.TypeMeta
+	api.ObjectMeta
+
+	Blobs         map[string]ImageLayerData
+	Images        map[string]ImageBlobReferences
+	ManifestLists map[string][]string
+}
+
+// ImageLayerData describes a single layer or config blob referenced by one or more images.
+type ImageLayerData struct {
+	Size      int64
+	MediaType string
+}
+
+// ImageBlobReferences describes the blob references within an image.
+type ImageBlobReferences struct {
+	Layers    []string
+	Config    *string
+	Manifests []string
+}
+
+// ImageSignaturePolicy describes which signatures are trusted for images matching a pull spec
+// pattern, for use by the image signature admission plugin.
+type ImageSignaturePolicy struct {
+	unversioned.TypeMeta
+	api.ObjectMeta
+
+	Rules []ImageSignatureRule
+}
+
+// ImageSignaturePolicyList is a list of ImageSignaturePolicy objects.
+type ImageSignaturePolicyList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+
+	Items []ImageSignaturePolicy
+}
+
+// ImageSignatureRule describes the signature trust requirements for images whose pull spec
+// matches Pattern.
+type ImageSignatureRule struct {
+	Pattern     string
+	Requirement SignatureRequirementType
+	Match       SignatureMatch
+}
+
+// SignatureRequirementType mirrors the containers/image trust model's signature requirement types.
+type SignatureRequirementType string
+
+const (
+	SignatureRequired      SignatureRequirementType = "signedBy"
+	MatchExact             SignatureRequirementType = "matchExact"
+	MatchRepoDigestOrExact SignatureRequirementType = "matchRepoDigestOrExact"
+	Reject                 SignatureRequirementType = "reject"
+)
+
+// SignatureMatch restricts which issuers and keys are trusted to satisfy an ImageSignatureRule.
+type SignatureMatch struct {
+	CommonName   string
+	Organization string
+	PublicKeyIDs []string
+	SignedClaims map[string]string
+}
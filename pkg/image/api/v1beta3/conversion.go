@@ -0,0 +1,474 @@
+package v1beta3
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/conversion"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func init() {
+	err := api.Scheme.AddConversionFuncs(
+		Convert_v1beta3_ImageStreamImport_To_api_ImageStreamImport,
+		Convert_api_ImageStreamImport_To_v1beta3_ImageStreamImport,
+		Convert_v1beta3_ImageStreamImportSpec_To_api_ImageStreamImportSpec,
+		Convert_api_ImageStreamImportSpec_To_v1beta3_ImageStreamImportSpec,
+		Convert_v1beta3_ImageStreamImportStatus_To_api_ImageStreamImportStatus,
+		Convert_api_ImageStreamImportStatus_To_v1beta3_ImageStreamImportStatus,
+		Convert_v1beta3_RepositoryImportSpec_To_api_RepositoryImportSpec,
+		Convert_api_RepositoryImportSpec_To_v1beta3_RepositoryImportSpec,
+		Convert_v1beta3_RepositoryImportStatus_To_api_RepositoryImportStatus,
+		Convert_api_RepositoryImportStatus_To_v1beta3_RepositoryImportStatus,
+		Convert_v1beta3_ImageImportSpec_To_api_ImageImportSpec,
+		Convert_api_ImageImportSpec_To_v1beta3_ImageImportSpec,
+		Convert_v1beta3_ImageImportStatus_To_api_ImageImportStatus,
+		Convert_api_ImageImportStatus_To_v1beta3_ImageImportStatus,
+		Convert_v1beta3_ImageTag_To_api_ImageTag,
+		Convert_api_ImageTag_To_v1beta3_ImageTag,
+		Convert_v1beta3_ImageStreamLayers_To_api_ImageStreamLayers,
+		Convert_api_ImageStreamLayers_To_v1beta3_ImageStreamLayers,
+		Convert_v1beta3_ImageSignaturePolicy_To_api_ImageSignaturePolicy,
+		Convert_api_ImageSignaturePolicy_To_v1beta3_ImageSignaturePolicy,
+		Convert_v1beta3_ImageStreamSpec_To_api_ImageStreamSpec,
+		Convert_api_ImageStreamSpec_To_v1beta3_ImageStreamSpec,
+		Convert_v1beta3_TagReference_To_api_TagReference,
+		Convert_api_TagReference_To_v1beta3_TagReference,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func Convert_v1beta3_ImageStreamImport_To_api_ImageStreamImport(in *ImageStreamImport, out *imageapi.ImageStreamImport, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	if err := s.Convert(&in.ObjectMeta, &out.ObjectMeta, 0); err != nil {
+		return err
+	}
+	if err := Convert_v1beta3_ImageStreamImportSpec_To_api_ImageStreamImportSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return Convert_v1beta3_ImageStreamImportStatus_To_api_ImageStreamImportStatus(&in.Status, &out.Status, s)
+}
+
+func Convert_api_ImageStreamImport_To_v1beta3_ImageStreamImport(in *imageapi.ImageStreamImport, out *ImageStreamImport, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	if err := s.Convert(&in.ObjectMeta, &out.ObjectMeta, 0); err != nil {
+		return err
+	}
+	if err := Convert_api_ImageStreamImportSpec_To_v1beta3_ImageStreamImportSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return Convert_api_ImageStreamImportStatus_To_v1beta3_ImageStreamImportStatus(&in.Status, &out.Status, s)
+}
+
+func Convert_v1beta3_ImageStreamImportSpec_To_api_ImageStreamImportSpec(in *ImageStreamImportSpec, out *imageapi.ImageStreamImportSpec, s conversion.Scope) error {
+	out.Import = in.Import
+	if in.Repository != nil {
+		out.Repository = &imageapi.RepositoryImportSpec{}
+		if err := Convert_v1beta3_RepositoryImportSpec_To_api_RepositoryImportSpec(in.Repository, out.Repository, s); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	out.Images = make([]imageapi.ImageImportSpec, len(in.Images))
+	for i := range in.Images {
+		if err := Convert_v1beta3_ImageImportSpec_To_api_ImageImportSpec(&in.Images[i], &out.Images[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Convert_api_ImageStreamImportSpec_To_v1beta3_ImageStreamImportSpec(in *imageapi.ImageStreamImportSpec, out *ImageStreamImportSpec, s conversion.Scope) error {
+	out.Import = in.Import
+	if in.Repository != nil {
+		out.Repository = &RepositoryImportSpec{}
+		if err := Convert_api_RepositoryImportSpec_To_v1beta3_RepositoryImportSpec(in.Repository, out.Repository, s); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	out.Images = make([]ImageImportSpec, len(in.Images))
+	for i := range in.Images {
+		if err := Convert_api_ImageImportSpec_To_v1beta3_ImageImportSpec(&in.Images[i], &out.Images[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Convert_v1beta3_ImageStreamImportStatus_To_api_ImageStreamImportStatus(in *ImageStreamImportStatus, out *imageapi.ImageStreamImportStatus, s conversion.Scope) error {
+	if in.Import != nil {
+		out.Import = &imageapi.ImageStream{}
+		if err := s.Convert(in.Import, out.Import, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Import = nil
+	}
+	if in.Repository != nil {
+		out.Repository = &imageapi.RepositoryImportStatus{}
+		if err := Convert_v1beta3_RepositoryImportStatus_To_api_RepositoryImportStatus(in.Repository, out.Repository, s); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	out.Images = make([]imageapi.ImageImportStatus, len(in.Images))
+	for i := range in.Images {
+		if err := Convert_v1beta3_ImageImportStatus_To_api_ImageImportStatus(&in.Images[i], &out.Images[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Convert_api_ImageStreamImportStatus_To_v1beta3_ImageStreamImportStatus(in *imageapi.ImageStreamImportStatus, out *ImageStreamImportStatus, s conversion.Scope) error {
+	if in.Import != nil {
+		out.Import = &ImageStream{}
+		if err := s.Convert(in.Import, out.Import, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Import = nil
+	}
+	if in.Repository != nil {
+		out.Repository = &RepositoryImportStatus{}
+		if err := Convert_api_RepositoryImportStatus_To_v1beta3_RepositoryImportStatus(in.Repository, out.Repository, s); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	out.Images = make([]ImageImportStatus, len(in.Images))
+	for i := range in.Images {
+		if err := Convert_api_ImageImportStatus_To_v1beta3_ImageImportStatus(&in.Images[i], &out.Images[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Convert_v1beta3_RepositoryImportSpec_To_api_RepositoryImportSpec(in *RepositoryImportSpec, out *imageapi.RepositoryImportSpec, s conversion.Scope) error {
+	if err := s.Convert(&in.From, &out.From, 0); err != nil {
+		return err
+	}
+	out.ImportPolicy = imageapi.TagImportPolicy{Insecure: in.ImportPolicy.Insecure, Scheduled: in.ImportPolicy.Scheduled}
+	out.IncludeManifest = in.IncludeManifest
+	return nil
+}
+
+func Convert_api_RepositoryImportSpec_To_v1beta3_RepositoryImportSpec(in *imageapi.RepositoryImportSpec, out *RepositoryImportSpec, s conversion.Scope) error {
+	if err := s.Convert(&in.From, &out.From, 0); err != nil {
+		return err
+	}
+	out.ImportPolicy = TagImportPolicy{Insecure: in.ImportPolicy.Insecure, Scheduled: in.ImportPolicy.Scheduled}
+	out.IncludeManifest = in.IncludeManifest
+	return nil
+}
+
+func Convert_v1beta3_RepositoryImportStatus_To_api_RepositoryImportStatus(in *RepositoryImportStatus, out *imageapi.RepositoryImportStatus, s conversion.Scope) error {
+	out.Status = in.Status
+	out.Images = make([]imageapi.ImageImportStatus, len(in.Images))
+	for i := range in.Images {
+		if err := Convert_v1beta3_ImageImportStatus_To_api_ImageImportStatus(&in.Images[i], &out.Images[i], s); err != nil {
+			return err
+		}
+	}
+	out.AdditionalTags = in.AdditionalTags
+	return nil
+}
+
+func Convert_api_RepositoryImportStatus_To_v1beta3_RepositoryImportStatus(in *imageapi.RepositoryImportStatus, out *RepositoryImportStatus, s conversion.Scope) error {
+	out.Status = in.Status
+	out.Images = make([]ImageImportStatus, len(in.Images))
+	for i := range in.Images {
+		if err := Convert_api_ImageImportStatus_To_v1beta3_ImageImportStatus(&in.Images[i], &out.Images[i], s); err != nil {
+			return err
+		}
+	}
+	out.AdditionalTags = in.AdditionalTags
+	return nil
+}
+
+func Convert_v1beta3_ImageImportSpec_To_api_ImageImportSpec(in *ImageImportSpec, out *imageapi.ImageImportSpec, s conversion.Scope) error {
+	if err := s.Convert(&in.From, &out.From, 0); err != nil {
+		return err
+	}
+	if in.To != nil {
+		to := *in.To
+		out.To = &to
+	} else {
+		out.To = nil
+	}
+	out.ImportPolicy = imageapi.TagImportPolicy{Insecure: in.ImportPolicy.Insecure, Scheduled: in.ImportPolicy.Scheduled}
+	out.IncludeManifest = in.IncludeManifest
+	return nil
+}
+
+func Convert_api_ImageImportSpec_To_v1beta3_ImageImportSpec(in *imageapi.ImageImportSpec, out *ImageImportSpec, s conversion.Scope) error {
+	if err := s.Convert(&in.From, &out.From, 0); err != nil {
+		return err
+	}
+	if in.To != nil {
+		to := *in.To
+		out.To = &to
+	} else {
+		out.To = nil
+	}
+	out.ImportPolicy = TagImportPolicy{Insecure: in.ImportPolicy.Insecure, Scheduled: in.ImportPolicy.Scheduled}
+	out.IncludeManifest = in.IncludeManifest
+	return nil
+}
+
+func Convert_v1beta3_ImageImportStatus_To_api_ImageImportStatus(in *ImageImportStatus, out *imageapi.ImageImportStatus, s conversion.Scope) error {
+	out.Status = in.Status
+	if in.Image != nil {
+		out.Image = &imageapi.Image{}
+		if err := s.Convert(in.Image, out.Image, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	out.Tag = in.Tag
+	return nil
+}
+
+func Convert_api_ImageImportStatus_To_v1beta3_ImageImportStatus(in *imageapi.ImageImportStatus, out *ImageImportStatus, s conversion.Scope) error {
+	out.Status = in.Status
+	if in.Image != nil {
+		out.Image = &Image{}
+		if err := s.Convert(in.Image, out.Image, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	out.Tag = in.Tag
+	return nil
+}
+
+func Convert_v1beta3_ImageTag_To_api_ImageTag(in *ImageTag, out *imageapi.ImageTag, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	if err := s.Convert(&in.ObjectMeta, &out.ObjectMeta, 0); err != nil {
+		return err
+	}
+	if in.Spec != nil {
+		spec := imageapi.TagReference{}
+		if err := s.Convert(in.Spec, &spec, 0); err != nil {
+			return err
+		}
+		out.Spec = &spec
+	} else {
+		out.Spec = nil
+	}
+	if in.Status != nil {
+		status := imageapi.NamedTagEventList{}
+		if err := s.Convert(in.Status, &status, 0); err != nil {
+			return err
+		}
+		out.Status = &status
+	} else {
+		out.Status = nil
+	}
+	if in.Image != nil {
+		image := imageapi.Image{}
+		if err := s.Convert(in.Image, &image, 0); err != nil {
+			return err
+		}
+		out.Image = &image
+	} else {
+		out.Image = nil
+	}
+	return nil
+}
+
+func Convert_api_ImageTag_To_v1beta3_ImageTag(in *imageapi.ImageTag, out *ImageTag, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	if err := s.Convert(&in.ObjectMeta, &out.ObjectMeta, 0); err != nil {
+		return err
+	}
+	if in.Spec != nil {
+		spec := TagReference{}
+		if err := s.Convert(in.Spec, &spec, 0); err != nil {
+			return err
+		}
+		out.Spec = &spec
+	} else {
+		out.Spec = nil
+	}
+	if in.Status != nil {
+		status := NamedTagEventList{}
+		if err := s.Convert(in.Status, &status, 0); err != nil {
+			return err
+		}
+		out.Status = &status
+	} else {
+		out.Status = nil
+	}
+	if in.Image != nil {
+		image := Image{}
+		if err := s.Convert(in.Image, &image, 0); err != nil {
+			return err
+		}
+		out.Image = &image
+	} else {
+		out.Image = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_ImageStreamLayers_To_api_ImageStreamLayers(in *ImageStreamLayers, out *imageapi.ImageStreamLayers, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	if err := s.Convert(&in.ObjectMeta, &out.ObjectMeta, 0); err != nil {
+		return err
+	}
+	out.Blobs = make(map[string]imageapi.ImageLayerData, len(in.Blobs))
+	for k, v := range in.Blobs {
+		out.Blobs[k] = imageapi.ImageLayerData{Size: v.Size, MediaType: v.MediaType}
+	}
+	out.Images = make(map[string]imageapi.ImageBlobReferences, len(in.Images))
+	for k, v := range in.Images {
+		out.Images[k] = imageapi.ImageBlobReferences{Layers: v.Layers, Config: v.Config, Manifests: v.Manifests}
+	}
+	out.ManifestLists = make(map[string][]string, len(in.ManifestLists))
+	for k, v := range in.ManifestLists {
+		digests := make([]string, len(v))
+		copy(digests, v)
+		out.ManifestLists[k] = digests
+	}
+	return nil
+}
+
+func convert_v1beta3_ImageSignatureRule_To_api_ImageSignatureRule(in *ImageSignatureRule) imageapi.ImageSignatureRule {
+	return imageapi.ImageSignatureRule{
+		Pattern:     in.Pattern,
+		Requirement: imageapi.SignatureRequirementType(in.Requirement),
+		Match: imageapi.SignatureMatch{
+			CommonName:   in.Match.CommonName,
+			Organization: in.Match.Organization,
+			PublicKeyIDs: in.Match.PublicKeyIDs,
+			SignedClaims: in.Match.SignedClaims,
+		},
+	}
+}
+
+func convert_api_ImageSignatureRule_To_v1beta3_ImageSignatureRule(in *imageapi.ImageSignatureRule) ImageSignatureRule {
+	return ImageSignatureRule{
+		Pattern:     in.Pattern,
+		Requirement: SignatureRequirementType(in.Requirement),
+		Match: SignatureMatch{
+			CommonName:   in.Match.CommonName,
+			Organization: in.Match.Organization,
+			PublicKeyIDs: in.Match.PublicKeyIDs,
+			SignedClaims: in.Match.SignedClaims,
+		},
+	}
+}
+
+func Convert_v1beta3_ImageSignaturePolicy_To_api_ImageSignaturePolicy(in *ImageSignaturePolicy, out *imageapi.ImageSignaturePolicy, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	if err := s.Convert(&in.ObjectMeta, &out.ObjectMeta, 0); err != nil {
+		return err
+	}
+	out.Rules = make([]imageapi.ImageSignatureRule, len(in.Rules))
+	for i := range in.Rules {
+		out.Rules[i] = convert_v1beta3_ImageSignatureRule_To_api_ImageSignatureRule(&in.Rules[i])
+	}
+	return nil
+}
+
+func Convert_api_ImageSignaturePolicy_To_v1beta3_ImageSignaturePolicy(in *imageapi.ImageSignaturePolicy, out *ImageSignaturePolicy, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	if err := s.Convert(&in.ObjectMeta, &out.ObjectMeta, 0); err != nil {
+		return err
+	}
+	out.Rules = make([]ImageSignatureRule, len(in.Rules))
+	for i := range in.Rules {
+		out.Rules[i] = convert_api_ImageSignatureRule_To_v1beta3_ImageSignatureRule(&in.Rules[i])
+	}
+	return nil
+}
+
+func Convert_v1beta3_ImageStreamSpec_To_api_ImageStreamSpec(in *ImageStreamSpec, out *imageapi.ImageStreamSpec, s conversion.Scope) error {
+	out.DockerImageRepository = in.DockerImageRepository
+	out.LookupPolicy = imageapi.ImageLookupPolicy{Local: in.LookupPolicy.Local}
+	out.Tags = make([]imageapi.TagReference, len(in.Tags))
+	for i := range in.Tags {
+		if err := Convert_v1beta3_TagReference_To_api_TagReference(&in.Tags[i], &out.Tags[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Convert_api_ImageStreamSpec_To_v1beta3_ImageStreamSpec(in *imageapi.ImageStreamSpec, out *ImageStreamSpec, s conversion.Scope) error {
+	out.DockerImageRepository = in.DockerImageRepository
+	out.LookupPolicy = ImageLookupPolicy{Local: in.LookupPolicy.Local}
+	out.Tags = make([]TagReference, len(in.Tags))
+	for i := range in.Tags {
+		if err := Convert_api_TagReference_To_v1beta3_TagReference(&in.Tags[i], &out.Tags[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Convert_v1beta3_TagReference_To_api_TagReference(in *TagReference, out *imageapi.TagReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Annotations = in.Annotations
+	if in.From != nil {
+		if err := s.Convert(&in.From, &out.From, 0); err != nil {
+			return err
+		}
+	} else {
+		out.From = nil
+	}
+	out.Reference = in.Reference
+	out.Generation = in.Generation
+	out.ImportPolicy = imageapi.TagImportPolicy{Insecure: in.ImportPolicy.Insecure, Scheduled: in.ImportPolicy.Scheduled}
+	out.LookupPolicy = imageapi.ImageLookupPolicy{Local: in.LookupPolicy.Local}
+	return nil
+}
+
+func Convert_api_TagReference_To_v1beta3_TagReference(in *imageapi.TagReference, out *TagReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Annotations = in.Annotations
+	if in.From != nil {
+		if err := s.Convert(&in.From, &out.From, 0); err != nil {
+			return err
+		}
+	} else {
+		out.From = nil
+	}
+	out.Reference = in.Reference
+	out.Generation = in.Generation
+	out.ImportPolicy = TagImportPolicy{Insecure: in.ImportPolicy.Insecure, Scheduled: in.ImportPolicy.Scheduled}
+	out.LookupPolicy = ImageLookupPolicy{Local: in.LookupPolicy.Local}
+	return nil
+}
+
+func Convert_api_ImageStreamLayers_To_v1beta3_ImageStreamLayers(in *imageapi.ImageStreamLayers, out *ImageStreamLayers, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	if err := s.Convert(&in.ObjectMeta, &out.ObjectMeta, 0); err != nil {
+		return err
+	}
+	out.Blobs = make(map[string]ImageLayerData, len(in.Blobs))
+	for k, v := range in.Blobs {
+		out.Blobs[k] = ImageLayerData{Size: v.Size, MediaType: v.MediaType}
+	}
+	out.Images = make(map[string]ImageBlobReferences, len(in.Images))
+	for k, v := range in.Images {
+		out.Images[k] = ImageBlobReferences{Layers: v.Layers, Config: v.Config, Manifests: v.Manifests}
+	}
+	out.ManifestLists = make(map[string][]string, len(in.ManifestLists))
+	for k, v := range in.ManifestLists {
+		digests := make([]string, len(v))
+		copy(digests, v)
+		out.ManifestLists[k] = digests
+	}
+	return nil
+}
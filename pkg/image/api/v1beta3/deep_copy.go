@@ -0,0 +1,90 @@
+package v1beta3
+
+// DeepCopy returns a copy of the ImageStreamImport whose spec and status have their own
+// pointers and slices. The ImageStream and Image values they point at are copied one level
+// deep, so their own slices and maps, like ObjectMeta's, are shared with in.
+func (in ImageStreamImport) DeepCopy() ImageStreamImport {
+	out := in
+	out.Spec = in.Spec.DeepCopy()
+	out.Status = in.Status.DeepCopy()
+	return out
+}
+
+// DeepCopy returns a deep copy of the ImageStreamImportSpec.
+func (in ImageStreamImportSpec) DeepCopy() ImageStreamImportSpec {
+	out := in
+	if in.Repository != nil {
+		repository := in.Repository.DeepCopy()
+		out.Repository = &repository
+	}
+	if in.Images != nil {
+		out.Images = make([]ImageImportSpec, len(in.Images))
+		for i := range in.Images {
+			out.Images[i] = in.Images[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// DeepCopy returns a copy of the ImageStreamImportStatus. Import and each Image are copied one
+// level deep; their own slices and maps are shared with in.
+func (in ImageStreamImportStatus) DeepCopy() ImageStreamImportStatus {
+	out := in
+	if in.Import != nil {
+		imp := *in.Import
+		out.Import = &imp
+	}
+	if in.Repository != nil {
+		repository := in.Repository.DeepCopy()
+		out.Repository = &repository
+	}
+	if in.Images != nil {
+		out.Images = make([]ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			out.Images[i] = in.Images[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// DeepCopy returns a deep copy of the RepositoryImportSpec.
+func (in RepositoryImportSpec) DeepCopy() RepositoryImportSpec {
+	out := in
+	return out
+}
+
+// DeepCopy returns a deep copy of the RepositoryImportStatus.
+func (in RepositoryImportStatus) DeepCopy() RepositoryImportStatus {
+	out := in
+	if in.Images != nil {
+		out.Images = make([]ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			out.Images[i] = in.Images[i].DeepCopy()
+		}
+	}
+	if in.AdditionalTags != nil {
+		out.AdditionalTags = append([]string{}, in.AdditionalTags...)
+	}
+	return out
+}
+
+// DeepCopy returns a deep copy of the ImageImportSpec.
+func (in ImageImportSpec) DeepCopy() ImageImportSpec {
+	out := in
+	if in.To != nil {
+		to := *in.To
+		out.To = &to
+	}
+	return out
+}
+
+// DeepCopy returns a copy of the ImageImportStatus. Image is copied one level deep; its slices
+// and maps are shared with in.
+func (in ImageImportStatus) DeepCopy() ImageImportStatus {
+	out := in
+	if in.Image != nil {
+		image := *in.Image
+		out.Image = &image
+	}
+	return out
+}
@@ -37,8 +37,44 @@ type Image struct {
 	DockerImageManifestMediaType string `json:"dockerImageManifestMediaType,omitempty"`
 	// DockerImageConfig is a JSON blob that the runtime uses to set up the container. This is a part of manifest schema v2.
 	DockerImageConfig string `json:"dockerImageConfig,omitempty"`
+	// DockerImageManifests holds information about sub-manifests of the image, used when the
+	// image is a manifest list (OCI image index or Docker manifest list) that fans out to
+	// per-platform child images. May not be set if the image is not a manifest list.
+	DockerImageManifests []ImageManifest `json:"dockerImageManifests,omitempty"`
 }
 
+// ImageManifest describes a single sub-manifest of a manifest list, usually a single
+// platform-specific image.
+type ImageManifest struct {
+	// Digest is the unique identifier for the manifest. It refers to an Image object.
+	Digest string `json:"digest"`
+	// MediaType defines the type of the manifest, possibly in conjunction with the manifest list mediaType.
+	MediaType string `json:"mediaType"`
+	// Architecture specifies the supported CPU architecture, for example `amd64` or `ppc64le`.
+	Architecture string `json:"architecture"`
+	// OS specifies the operating system, for example `linux`.
+	OS string `json:"os"`
+	// Variant is an optional field representing a variant of the CPU, for example `v6` to specify
+	// a particular CPU variant of the ARM CPU.
+	Variant string `json:"variant,omitempty"`
+	// OSVersion is an optional field that specifies the operating system version, for example `10.0.10586`.
+	OSVersion string `json:"osVersion,omitempty"`
+	// OSFeatures is an optional field that specifies an array of strings, each listing a required OS feature.
+	OSFeatures []string `json:"osFeatures,omitempty"`
+	// Size is the size in bytes of the image's mediaType, i.e. the raw manifest this references describes.
+	Size int64 `json:"size,omitempty"`
+	// Manifest is the raw JSON of the child manifest this entry describes, if it was retrieved
+	// during import. May not be set if the data was not fetched or retained.
+	Manifest []byte `json:"manifest,omitempty"`
+}
+
+const (
+	// ImageManifestParentAnnotation is set on an Image that was materialized from a single entry
+	// of another Image's DockerImageManifests, and holds the name of that parent manifest list
+	// Image.
+	ImageManifestParentAnnotation = "image.openshift.io/parentManifest"
+)
+
 // ImageLayer represents a single layer of the image. Some images may have multiple layers. Some may have none.
 type ImageLayer struct {
 	// Name of the layer as defined by the underlying store.
@@ -81,9 +117,19 @@ type ImageSignature struct {
 	IssuedTo *SignatureSubject `json:"issuedTo,omitempty"`
 }
 
-/// SignatureConditionType is a type of image signature condition.
+// / SignatureConditionType is a type of image signature condition.
 type SignatureConditionType string
 
+// These are valid conditions of an image signature.
+const (
+	// SignatureTrusted means the signature has been matched against a policy rule and is
+	// considered trusted by the cluster's signature policy.
+	SignatureTrusted SignatureConditionType = "Trusted"
+	// SignatureRejected means the signature was evaluated against the cluster's signature
+	// policy and did not satisfy any matching rule.
+	SignatureRejected SignatureConditionType = "Rejected"
+)
+
 // SignatureCondition describes an image signature condition of particular kind at particular probe time.
 type SignatureCondition struct {
 	// Type of job condition, Complete or Failed.
@@ -150,6 +196,19 @@ type ImageStreamSpec struct {
 	DockerImageRepository string `json:"dockerImageRepository,omitempty"`
 	// Tags map arbitrary string values to specific image locators
 	Tags []TagReference `json:"tags,omitempty"`
+	// LookupPolicy controls how other resources reference images within this namespace.
+	LookupPolicy ImageLookupPolicy `json:"lookupPolicy,omitempty"`
+}
+
+// ImageLookupPolicy describes how an image stream can be used to override the image references
+// used by pods in a namespace.
+type ImageLookupPolicy struct {
+	// Local will change the docker short image references (like "mystream:latest") supplied in
+	// pod specs to be resolved to a pinned digest pull spec when this stream
+	// is present. This allows references that are in the image stream to avoid pulling from the
+	// actual remote repository, but also still allows them to fall back to the remote repository
+	// if the reference is not found locally.
+	Local bool `json:"local,omitempty"`
 }
 
 // TagReference specifies optional annotations for images using this tag and an optional reference to an ImageStreamTag, ImageStreamImage, or DockerImage this tag should track.
@@ -164,6 +223,8 @@ type TagReference struct {
 	Generation *int64 `json:"generation"`
 	// Import is information that controls how images may be imported by the server.
 	ImportPolicy TagImportPolicy `json:"importPolicy,omitempty"`
+	// LookupPolicy controls how other resources reference images within this namespace.
+	LookupPolicy ImageLookupPolicy `json:"lookupPolicy,omitempty"`
 }
 
 type TagImportPolicy struct {
@@ -253,6 +314,30 @@ type ImageStreamTagList struct {
 	Items []ImageStreamTag `json:"items"`
 }
 
+// ImageTag represents a single tag on an ImageStream, combining the spec and status of that tag
+// along with the resolved Image it currently points at, if any. It allows clients to read the tag
+// configuration, history, and resolved image in a single round trip; only the tag configuration
+// (Spec) can be written.
+type ImageTag struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	// Spec is the spec tag associated with this image tag, if any
+	Spec *TagReference `json:"spec,omitempty"`
+	// Status is the status tag associated with this image tag, if any
+	Status *NamedTagEventList `json:"status,omitempty"`
+	// Image is the image associated with this image tag, if any
+	Image *Image `json:"image,omitempty"`
+}
+
+// ImageTagList is a list of ImageTag objects.
+type ImageTagList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	Items []ImageTag `json:"items"`
+}
+
 // ImageStreamImage represents an Image that is retrieved by image name from an ImageStream.
 type ImageStreamImage struct {
 	Image     `json:",inline"`
@@ -267,3 +352,192 @@ type DockerImageReference struct {
 	Tag       string
 	ID        string
 }
+
+// ImageStreamImport is a request to import images from an external Docker registry. The result
+// of each requested image and repository is reported on status; the image stream is only
+// updated when spec.import is true.
+type ImageStreamImport struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	// Spec is a description of the images that the user wishes to import
+	Spec ImageStreamImportSpec `json:"spec"`
+	// Status is the result of importing the image
+	Status ImageStreamImportStatus `json:"status,omitempty"`
+}
+
+// ImageStreamImportSpec defines what images should be imported.
+type ImageStreamImportSpec struct {
+	// Import indicates whether to perform an import - if so, each successfully imported image is
+	// recorded on the status tags of the image stream named by this object's metadata. Spec tags
+	// are not changed.
+	Import bool `json:"import"`
+	// Repository is an optional import of an entire Docker image repository. A maximum limit on the
+	// number of tags imported this way is imposed by the server.
+	Repository *RepositoryImportSpec `json:"repository,omitempty"`
+	// Images are a list of individual images to import.
+	Images []ImageImportSpec `json:"images,omitempty"`
+}
+
+// ImageStreamImportStatus contains information about the status of the import.
+type ImageStreamImportStatus struct {
+	// Import is the image stream that was successfully updated when spec.import was true.
+	Import *ImageStream `json:"import,omitempty"`
+	// Repository is set if spec.repository was set to the outcome of the import
+	Repository *RepositoryImportStatus `json:"repository,omitempty"`
+	// Images is set with the result of importing spec.images
+	Images []ImageImportStatus `json:"images,omitempty"`
+}
+
+// RepositoryImportSpec describes a request to import images from a Docker image repository.
+type RepositoryImportSpec struct {
+	// From is the source for the image repository to import; only kind DockerImage and a name of a
+	// Docker image reference will be used
+	From kapi.ObjectReference `json:"from"`
+
+	// ImportPolicy is the policy controlling how the image is imported
+	ImportPolicy TagImportPolicy `json:"importPolicy,omitempty"`
+	// IncludeManifest determines if the manifest for each image is returned in the response
+	IncludeManifest bool `json:"includeManifest,omitempty"`
+}
+
+// RepositoryImportStatus describes the result of an image repository import
+type RepositoryImportStatus struct {
+	// Status reflects whether any failure occurred during import
+	Status unversioned.Status `json:"status,omitempty"`
+	// Images is a list of images successfully retrieved by the import of the repository.
+	Images []ImageImportStatus `json:"images,omitempty"`
+	// AdditionalTags are tags that exist in the repository but were not imported because
+	// a maximum limit of automatic imports was applied.
+	AdditionalTags []string `json:"additionalTags,omitempty"`
+}
+
+// ImageImportSpec describes a request to import a specific image.
+type ImageImportSpec struct {
+	// From is the source of an image to import; only kind DockerImage and a name of a Docker image reference will be used
+	From kapi.ObjectReference `json:"from"`
+	// To is a tag in the current image stream to assign the imported image to, if name is not specified the default
+	// tag from from.name will be used
+	To *kapi.LocalObjectReference `json:"to,omitempty"`
+
+	// ImportPolicy is the policy controlling how the image is imported
+	ImportPolicy TagImportPolicy `json:"importPolicy,omitempty"`
+	// IncludeManifest determines if the manifest for each image is returned in the response
+	IncludeManifest bool `json:"includeManifest,omitempty"`
+}
+
+// ImageImportStatus describes the result of an image import.
+type ImageImportStatus struct {
+	// Status is the status of the image import, including errors encountered while retrieving the image
+	Status unversioned.Status `json:"status"`
+	// Image is the metadata of that image, if the image was located
+	Image *Image `json:"image,omitempty"`
+	// Tag is the tag this image was located under, if any
+	Tag string `json:"tag,omitempty"`
+}
+
+// ImageStreamLayers describes information about the layers and images associated with the
+// status tags of an image stream, without requiring a client to retrieve every referenced
+// Image object.
+type ImageStreamLayers struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	// Blobs is a map of blob digest to the metadata about the blob.
+	Blobs map[string]ImageLayerData `json:"blobs"`
+	// Images is a map of image name to the list of blobs and the config that composes the image.
+	Images map[string]ImageBlobReferences `json:"images"`
+	// ManifestLists is a map of manifest list digest to the digests of the per-platform child
+	// manifests it resolves to, aggregated across every image referenced by the stream's status
+	// tags. This lets callers resolve a manifest list without walking every image's Manifests
+	// field individually.
+	ManifestLists map[string][]string `json:"manifestLists,omitempty"`
+}
+
+// ImageLayerData describes a single layer or config blob referenced by one or more images.
+type ImageLayerData struct {
+	// Size of the blob in bytes as defined by the underlying store.
+	Size int64 `json:"size"`
+	// MediaType of the referenced object.
+	MediaType string `json:"mediaType"`
+}
+
+// ImageBlobReferences describes the blob references within an image.
+type ImageBlobReferences struct {
+	// Layers is the list of blobs that compose this image, from base to top layer.
+	Layers []string `json:"layers,omitempty"`
+	// Config, if set, is the blob that contains the image config. Some images do not have
+	// separate config blobs and this field may be unset.
+	Config *string `json:"config,omitempty"`
+	// Manifests is the list of other images that this image points to. This field is used
+	// when an image is a manifest list or image index which references per-platform child
+	// manifests.
+	Manifests []string `json:"manifests,omitempty"`
+}
+
+// ImageSignaturePolicy describes which signatures are trusted for images matching a pull spec
+// pattern, for use by the image signature admission plugin. A policy with no namespace applies
+// cluster-wide; a namespaced policy only constrains images referenced by pods in that namespace.
+type ImageSignaturePolicy struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	// Rules is an ordered list of signature trust rules. The first rule whose Pattern matches
+	// an image's DockerImageReference is applied; an image matching no rule is rejected.
+	Rules []ImageSignatureRule `json:"rules"`
+}
+
+// ImageSignaturePolicyList is a list of ImageSignaturePolicy objects.
+type ImageSignaturePolicyList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	Items []ImageSignaturePolicy `json:"items"`
+}
+
+// ImageSignatureRule describes the signature trust requirements for images whose pull spec
+// matches Pattern.
+type ImageSignatureRule struct {
+	// Pattern is a glob over the registry/namespace/name portion of a DockerImageReference
+	// that this rule applies to, e.g. "registry.access.redhat.com/*".
+	Pattern string `json:"pattern"`
+	// Requirement is the trust requirement that must be satisfied for a matching image.
+	Requirement SignatureRequirementType `json:"requirement"`
+	// Match restricts which signatures satisfy this rule. A signature satisfies the rule
+	// when its IssuedBy, PublicKeyID and SignedClaims match Match, and, if Requirement is
+	// MatchExact or MatchRepoDigestOrExact, its ImageIdentity agrees with the image being
+	// verified.
+	Match SignatureMatch `json:"match,omitempty"`
+}
+
+// SignatureRequirementType mirrors the containers/image trust model's signature requirement
+// types.
+type SignatureRequirementType string
+
+const (
+	// SignatureRequired means at least one signature satisfying Match must be present and trusted.
+	SignatureRequired SignatureRequirementType = "signedBy"
+	// MatchExact means a trusted signature's ImageIdentity must equal the resolved image's
+	// DockerImageReference.
+	MatchExact SignatureRequirementType = "matchExact"
+	// MatchRepoDigestOrExact means a trusted signature's ImageIdentity must either equal the
+	// resolved image's DockerImageReference or name the same repository pinned to that
+	// image's own digest.
+	MatchRepoDigestOrExact SignatureRequirementType = "matchRepoDigestOrExact"
+	// Reject means no image matching Pattern may be used, regardless of signature.
+	Reject SignatureRequirementType = "reject"
+)
+
+// SignatureMatch restricts which issuers and keys are trusted to satisfy an ImageSignatureRule.
+type SignatureMatch struct {
+	// CommonName, if specified, must equal IssuedBy.CommonName on a trusted signature.
+	CommonName string `json:"commonName,omitempty"`
+	// Organization, if specified, must equal IssuedBy.Organization on a trusted signature.
+	Organization string `json:"organization,omitempty"`
+	// PublicKeyIDs, if specified, restricts trust to signatures whose IssuedTo.PublicKeyID is
+	// one of these values.
+	PublicKeyIDs []string `json:"publicKeyIDs,omitempty"`
+	// SignedClaims, if specified, must all be present with equal values in the signature's
+	// ImageSignature.SignedClaims for the signature to satisfy this rule.
+	SignedClaims map[string]string `json:"signedClaims,omitempty"`
+}
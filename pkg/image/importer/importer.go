@@ -0,0 +1,18 @@
+// Package importer fetches repository and image metadata from remote Docker registries on
+// behalf of ImageStreamImport, without ever persisting anything itself - callers decide
+// whether to commit the result.
+package importer
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// RepositoryImporter retrieves image and repository metadata from a remote registry.
+type RepositoryImporter interface {
+	// ImportRepository fetches the tags and images of a Docker image repository.
+	ImportRepository(ctx kapi.Context, spec *imageapi.RepositoryImportSpec) *imageapi.RepositoryImportStatus
+	// ImportImage fetches the metadata of a single Docker image.
+	ImportImage(ctx kapi.Context, spec imageapi.ImageImportSpec) imageapi.ImageImportStatus
+}
@@ -0,0 +1,169 @@
+package imagestreamimport
+
+import (
+	"fmt"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/image/importer"
+)
+
+// REST implements the RESTStorage interface for ImageStreamImport. Creating an
+// ImageStreamImport fetches the requested repository and/or images from their remote
+// registries, records the result on the returned object's status, and only persists
+// the resolved tags onto the backing ImageStream when spec.import is true. This lets
+// callers preview what an import would do before committing to it.
+type REST struct {
+	streams  imageStreamGetUpdater
+	images   imageCreator
+	importer importer.RepositoryImporter
+}
+
+// imageStreamGetUpdater is the subset of the ImageStream registry that REST needs in
+// order to apply a confirmed import.
+type imageStreamGetUpdater interface {
+	GetImageStream(ctx kapi.Context, name string) (*imageapi.ImageStream, error)
+	UpdateImageStream(ctx kapi.Context, stream *imageapi.ImageStream) (*imageapi.ImageStream, error)
+}
+
+// imageCreator is the subset of the Image registry that REST needs in order to
+// materialize the per-platform child images of an imported manifest list.
+type imageCreator interface {
+	CreateImage(ctx kapi.Context, image *imageapi.Image) (*imageapi.Image, error)
+}
+
+// NewREST creates a new REST for ImageStreamImport backed by the given ImageStream
+// registry, Image registry, and repository importer.
+func NewREST(streams imageStreamGetUpdater, images imageCreator, importer importer.RepositoryImporter) *REST {
+	return &REST{streams: streams, images: images, importer: importer}
+}
+
+// New returns a new ImageStreamImport for use with Create.
+func (r *REST) New() runtime.Object {
+	return &imageapi.ImageStreamImport{}
+}
+
+// Create fetches the requested repository and/or images and returns the result on
+// status. The backing ImageStream is only updated when isi.Spec.Import is true;
+// otherwise the import is a dry run purely for rendering metadata to the caller.
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	isi, ok := obj.(*imageapi.ImageStreamImport)
+	if !ok {
+		return nil, fmt.Errorf("obj is not an ImageStreamImport: %#v", obj)
+	}
+
+	stream, err := r.streams.GetImageStream(ctx, isi.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := isi.DeepCopy()
+
+	if isi.Spec.Repository != nil {
+		repoStatus := r.importer.ImportRepository(ctx, isi.Spec.Repository)
+		result.Status.Repository = repoStatus
+	}
+	for _, image := range isi.Spec.Images {
+		result.Status.Images = append(result.Status.Images, r.importer.ImportImage(ctx, image))
+	}
+
+	if !isi.Spec.Import {
+		return &result, nil
+	}
+
+	if err := r.materializeManifestLists(ctx, &result.Status); err != nil {
+		return nil, err
+	}
+
+	updated := applyImportStatus(stream, &result.Status)
+	if _, err := r.streams.UpdateImageStream(ctx, updated); err != nil {
+		return nil, err
+	}
+	result.Status.Import = updated
+
+	return &result, nil
+}
+
+// materializeManifestLists creates a child Image object for every per-platform entry of
+// every imported manifest list, so that each platform-specific image can be retrieved
+// and tagged on its own. Children are linked back to the manifest list that produced
+// them via the ImageManifestParentAnnotation. Images that already exist are left alone.
+// The manifest list itself is what gets tagged onto the stream; resolving a TagEvent to one
+// of these children is left to something platform-aware (e.g. the kubelet/CRI), since the
+// apiserver handling this import has no way to know which platform a future pod will target.
+func (r *REST) materializeManifestLists(ctx kapi.Context, status *imageapi.ImageStreamImportStatus) error {
+	if status.Repository != nil {
+		for _, image := range status.Repository.Images {
+			if err := r.materializeManifestList(ctx, image.Image); err != nil {
+				return err
+			}
+		}
+	}
+	for _, image := range status.Images {
+		if err := r.materializeManifestList(ctx, image.Image); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// materializeManifestList creates a child Image for every entry of image's
+// DockerImageManifests, pinning each child's pull spec to its own digest rather than the
+// parent list's. image may be nil if the corresponding import failed.
+func (r *REST) materializeManifestList(ctx kapi.Context, image *imageapi.Image) error {
+	if image == nil {
+		return nil
+	}
+	for _, manifest := range image.DockerImageManifests {
+		child := &imageapi.Image{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:        manifest.Digest,
+				Annotations: map[string]string{imageapi.ImageManifestParentAnnotation: image.Name},
+			},
+			DockerImageReference:         childImageReference(image.DockerImageReference, manifest.Digest),
+			DockerImageManifest:          string(manifest.Manifest),
+			DockerImageManifestMediaType: manifest.MediaType,
+		}
+		if _, err := r.images.CreateImage(ctx, child); err != nil && !kapierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// childImageReference rewrites parentRef, the manifest list's pull spec, into a pull spec for
+// one of its children by replacing any tag or digest with digest.
+func childImageReference(parentRef, digest string) string {
+	repo := parentRef
+	if idx := strings.Index(parentRef, "@"); idx != -1 {
+		repo = parentRef[:idx]
+	} else if idx := strings.LastIndex(parentRef, ":"); idx > strings.LastIndex(parentRef, "/") {
+		repo = parentRef[:idx]
+	}
+	return repo + "@" + digest
+}
+
+// applyImportStatus tags every successfully imported image onto the stream so that a
+// subsequent Create with spec.import=true is the only path that mutates status.tags.
+func applyImportStatus(stream *imageapi.ImageStream, status *imageapi.ImageStreamImportStatus) *imageapi.ImageStream {
+	updated := stream.DeepCopy()
+	if status.Repository != nil {
+		for _, image := range status.Repository.Images {
+			if image.Image == nil || image.Tag == "" {
+				continue
+			}
+			imageapi.AddTagEventToImageStream(&updated, image.Tag, *image.Image)
+		}
+	}
+	for _, image := range status.Images {
+		if image.Image == nil || image.Tag == "" {
+			continue
+		}
+		imageapi.AddTagEventToImageStream(&updated, image.Tag, *image.Image)
+	}
+	return &updated
+}
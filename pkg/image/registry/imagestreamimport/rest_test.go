@@ -0,0 +1,173 @@
+package imagestreamimport
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+type fakeStreams struct {
+	stream  *imageapi.ImageStream
+	updated *imageapi.ImageStream
+}
+
+func (f *fakeStreams) GetImageStream(ctx kapi.Context, name string) (*imageapi.ImageStream, error) {
+	return f.stream, nil
+}
+
+func (f *fakeStreams) UpdateImageStream(ctx kapi.Context, stream *imageapi.ImageStream) (*imageapi.ImageStream, error) {
+	f.updated = stream
+	return stream, nil
+}
+
+type fakeImages struct {
+	created []*imageapi.Image
+}
+
+func (f *fakeImages) CreateImage(ctx kapi.Context, image *imageapi.Image) (*imageapi.Image, error) {
+	f.created = append(f.created, image)
+	return image, nil
+}
+
+type fakeImporter struct{}
+
+func (fakeImporter) ImportRepository(ctx kapi.Context, spec *imageapi.RepositoryImportSpec) *imageapi.RepositoryImportStatus {
+	return &imageapi.RepositoryImportStatus{}
+}
+
+func (fakeImporter) ImportImage(ctx kapi.Context, spec imageapi.ImageImportSpec) imageapi.ImageImportStatus {
+	tag := "latest"
+	if spec.To != nil {
+		tag = spec.To.Name
+	}
+	return imageapi.ImageImportStatus{
+		Tag:   tag,
+		Image: &imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: "sha256:abc"}, DockerImageReference: "registry/foo@sha256:abc"},
+	}
+}
+
+func TestCreateDryRunDoesNotPersist(t *testing.T) {
+	streams := &fakeStreams{stream: &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Name: "foo"}}}
+	r := NewREST(streams, &fakeImages{}, fakeImporter{})
+
+	isi := &imageapi.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo"},
+		Spec: imageapi.ImageStreamImportSpec{
+			Import: false,
+			Images: []imageapi.ImageImportSpec{{To: &kapi.LocalObjectReference{Name: "latest"}}},
+		},
+	}
+
+	obj, err := r.Create(kapi.NewContext(), isi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := obj.(*imageapi.ImageStreamImport)
+	if len(result.Status.Images) != 1 {
+		t.Fatalf("expected 1 image status, got %d", len(result.Status.Images))
+	}
+	if streams.updated != nil {
+		t.Fatalf("expected stream not to be persisted on a dry run, got %#v", streams.updated)
+	}
+}
+
+func TestCreateImportPersistsTags(t *testing.T) {
+	streams := &fakeStreams{stream: &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Name: "foo"}}}
+	r := NewREST(streams, &fakeImages{}, fakeImporter{})
+
+	isi := &imageapi.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo"},
+		Spec: imageapi.ImageStreamImportSpec{
+			Import: true,
+			Images: []imageapi.ImageImportSpec{{To: &kapi.LocalObjectReference{Name: "latest"}}},
+		},
+	}
+
+	if _, err := r.Create(kapi.NewContext(), isi); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if streams.updated == nil {
+		t.Fatalf("expected stream to be persisted when spec.import is true")
+	}
+	if len(streams.updated.Status.Tags) != 1 || streams.updated.Status.Tags[0].Tag != "latest" {
+		t.Fatalf("expected tag %q to be recorded, got %#v", "latest", streams.updated.Status.Tags)
+	}
+}
+
+type manifestListImporter struct{}
+
+func (manifestListImporter) ImportRepository(ctx kapi.Context, spec *imageapi.RepositoryImportSpec) *imageapi.RepositoryImportStatus {
+	return &imageapi.RepositoryImportStatus{}
+}
+
+func (manifestListImporter) ImportImage(ctx kapi.Context, spec imageapi.ImageImportSpec) imageapi.ImageImportStatus {
+	return imageapi.ImageImportStatus{
+		Tag: "latest",
+		Image: &imageapi.Image{
+			ObjectMeta:           kapi.ObjectMeta{Name: "sha256:list"},
+			DockerImageReference: "registry/foo@sha256:list",
+			DockerImageManifests: []imageapi.ImageManifest{
+				{Digest: "sha256:amd64", Architecture: "amd64", OS: "linux"},
+				{Digest: "sha256:arm64", Architecture: "arm64", OS: "linux"},
+			},
+		},
+	}
+}
+
+func TestCreateImportMaterializesManifestListChildren(t *testing.T) {
+	streams := &fakeStreams{stream: &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Name: "foo"}}}
+	images := &fakeImages{}
+	r := NewREST(streams, images, manifestListImporter{})
+
+	isi := &imageapi.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo"},
+		Spec: imageapi.ImageStreamImportSpec{
+			Import: true,
+			Images: []imageapi.ImageImportSpec{{To: &kapi.LocalObjectReference{Name: "latest"}}},
+		},
+	}
+
+	if _, err := r.Create(kapi.NewContext(), isi); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(images.created) != 2 {
+		t.Fatalf("expected 2 child images to be created, got %d", len(images.created))
+	}
+	for _, child := range images.created {
+		if child.Annotations[imageapi.ImageManifestParentAnnotation] != "sha256:list" {
+			t.Fatalf("expected child image to be annotated with parent manifest list, got %#v", child.Annotations)
+		}
+		if child.DockerImageReference != "registry/foo@"+child.Name {
+			t.Fatalf("expected child image to be pinned to its own digest, got %q", child.DockerImageReference)
+		}
+	}
+}
+
+func TestCreateImportTagsTheManifestListItself(t *testing.T) {
+	streams := &fakeStreams{stream: &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Name: "foo"}}}
+	images := &fakeImages{}
+	r := NewREST(streams, images, manifestListImporter{})
+
+	isi := &imageapi.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo"},
+		Spec: imageapi.ImageStreamImportSpec{
+			Import: true,
+			Images: []imageapi.ImageImportSpec{{To: &kapi.LocalObjectReference{Name: "latest"}}},
+		},
+	}
+
+	if _, err := r.Create(kapi.NewContext(), isi); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(streams.updated.Status.Tags) != 1 {
+		t.Fatalf("expected 1 tag to be recorded, got %#v", streams.updated.Status.Tags)
+	}
+	// The apiserver has no way to know which platform a future pod will target, so the list
+	// itself is what gets tagged; resolving to a specific child is left to the kubelet/CRI.
+	tagged := streams.updated.Status.Tags[0].Items[0].Image
+	if tagged != "sha256:list" {
+		t.Fatalf("expected tag to resolve to the manifest list image, got %q", tagged)
+	}
+}
@@ -0,0 +1,104 @@
+package imagestreamlayers
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// imageStreamGetter is the subset of the ImageStream registry that REST needs to discover
+// which images a stream currently references.
+type imageStreamGetter interface {
+	GetImageStream(ctx kapi.Context, name string) (*imageapi.ImageStream, error)
+}
+
+// imageGetter resolves an Image by name.
+type imageGetter interface {
+	GetImage(ctx kapi.Context, name string) (*imageapi.Image, error)
+}
+
+// REST implements GET for the imageStreamLayers subresource. It aggregates blob and layer
+// metadata from every image referenced by the stream's status tags, so that callers such as
+// mirror tools, GC, and admission controllers can compute disk usage or verify blob presence
+// without walking every Image object individually.
+type REST struct {
+	streams imageStreamGetter
+	images  imageGetter
+}
+
+// NewREST creates a new REST for the imageStreamLayers subresource.
+func NewREST(streams imageStreamGetter, images imageGetter) *REST {
+	return &REST{streams: streams, images: images}
+}
+
+// New returns a new ImageStreamLayers for use with Get.
+func (r *REST) New() runtime.Object {
+	return &imageapi.ImageStreamLayers{}
+}
+
+// Get aggregates blob, image, and manifest-list metadata for every image referenced by name's
+// status tags, including the older entries of each tag's history.
+func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	stream, err := r.streams.GetImageStream(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	layers := &imageapi.ImageStreamLayers{
+		ObjectMeta:    kapi.ObjectMeta{Name: name, Namespace: stream.Namespace},
+		Blobs:         map[string]imageapi.ImageLayerData{},
+		Images:        map[string]imageapi.ImageBlobReferences{},
+		ManifestLists: map[string][]string{},
+	}
+
+	seen := map[string]bool{}
+	for _, tag := range stream.Status.Tags {
+		for _, event := range tag.Items {
+			if event.Image == "" || seen[event.Image] {
+				continue
+			}
+			seen[event.Image] = true
+
+			image, err := r.images.GetImage(ctx, event.Image)
+			if err != nil {
+				return nil, err
+			}
+			addImage(layers, image)
+		}
+	}
+
+	return layers, nil
+}
+
+// addImage records image's blobs, config, and manifest list entries into layers.
+func addImage(layers *imageapi.ImageStreamLayers, image *imageapi.Image) {
+	refs := imageapi.ImageBlobReferences{}
+
+	for _, l := range image.DockerImageLayers {
+		layers.Blobs[l.Name] = imageapi.ImageLayerData{Size: l.Size, MediaType: l.MediaType}
+		refs.Layers = append(refs.Layers, l.Name)
+	}
+	if len(image.DockerImageConfig) > 0 {
+		config := configDigest(image.DockerImageConfig)
+		layers.Blobs[config] = imageapi.ImageLayerData{Size: int64(len(image.DockerImageConfig)), MediaType: "application/vnd.docker.container.image.v1+json"}
+		refs.Config = &config
+	}
+	for _, manifest := range image.DockerImageManifests {
+		refs.Manifests = append(refs.Manifests, manifest.Digest)
+	}
+	if len(refs.Manifests) > 0 {
+		layers.ManifestLists[image.Name] = refs.Manifests
+	}
+
+	layers.Images[image.Name] = refs
+}
+
+// configDigest returns the sha256 digest of a config blob, in "sha256:<hex>" form, so it can be
+// looked up in layers.Blobs the same way any other blob digest is.
+func configDigest(config string) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(config)))
+}
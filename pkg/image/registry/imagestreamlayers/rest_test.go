@@ -0,0 +1,104 @@
+package imagestreamlayers
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+type fakeStreams struct {
+	stream *imageapi.ImageStream
+}
+
+func (f *fakeStreams) GetImageStream(ctx kapi.Context, name string) (*imageapi.ImageStream, error) {
+	return f.stream, nil
+}
+
+type fakeImages struct {
+	images map[string]*imageapi.Image
+}
+
+func (f *fakeImages) GetImage(ctx kapi.Context, name string) (*imageapi.Image, error) {
+	return f.images[name], nil
+}
+
+func TestGetAggregatesBlobsAcrossTags(t *testing.T) {
+	streams := &fakeStreams{stream: &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo"},
+		Status: imageapi.ImageStreamStatus{
+			Tags: []imageapi.NamedTagEventList{
+				{Tag: "v1", Items: []imageapi.TagEvent{{Image: "sha256:aaa"}}},
+				{Tag: "latest", Items: []imageapi.TagEvent{{Image: "sha256:aaa"}, {Image: "sha256:bbb"}}},
+			},
+		},
+	}}
+	images := &fakeImages{images: map[string]*imageapi.Image{
+		"sha256:aaa": {
+			ObjectMeta:        kapi.ObjectMeta{Name: "sha256:aaa"},
+			DockerImageLayers: []imageapi.ImageLayer{{Name: "sha256:layer1", Size: 100, MediaType: "layer"}},
+			DockerImageConfig: "{}",
+		},
+		"sha256:bbb": {
+			ObjectMeta:        kapi.ObjectMeta{Name: "sha256:bbb"},
+			DockerImageLayers: []imageapi.ImageLayer{{Name: "sha256:layer2", Size: 200, MediaType: "layer"}},
+		},
+	}}
+	r := NewREST(streams, images)
+
+	obj, err := r.Get(kapi.NewContext(), "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	layers := obj.(*imageapi.ImageStreamLayers)
+
+	if len(layers.Images) != 2 {
+		t.Fatalf("expected each distinct image to be visited once, got %d", len(layers.Images))
+	}
+	if _, ok := layers.Blobs["sha256:layer1"]; !ok {
+		t.Fatalf("expected layer1 blob to be recorded, got %#v", layers.Blobs)
+	}
+	if _, ok := layers.Blobs["sha256:layer2"]; !ok {
+		t.Fatalf("expected layer2 blob to be recorded, got %#v", layers.Blobs)
+	}
+	config := layers.Images["sha256:aaa"].Config
+	if config == nil {
+		t.Fatalf("expected config digest to be recorded for sha256:aaa")
+	}
+	if _, ok := layers.Blobs[*config]; !ok {
+		t.Fatalf("expected config digest %q to resolve to a blob, got %#v", *config, layers.Blobs)
+	}
+}
+
+func TestGetRecordsManifestListEntries(t *testing.T) {
+	streams := &fakeStreams{stream: &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo"},
+		Status: imageapi.ImageStreamStatus{
+			Tags: []imageapi.NamedTagEventList{
+				{Tag: "latest", Items: []imageapi.TagEvent{{Image: "sha256:list"}}},
+			},
+		},
+	}}
+	images := &fakeImages{images: map[string]*imageapi.Image{
+		"sha256:list": {
+			ObjectMeta: kapi.ObjectMeta{Name: "sha256:list"},
+			DockerImageManifests: []imageapi.ImageManifest{
+				{Digest: "sha256:amd64", Architecture: "amd64", OS: "linux"},
+				{Digest: "sha256:arm64", Architecture: "arm64", OS: "linux"},
+			},
+		},
+	}}
+	r := NewREST(streams, images)
+
+	obj, err := r.Get(kapi.NewContext(), "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	layers := obj.(*imageapi.ImageStreamLayers)
+
+	manifests := layers.ManifestLists["sha256:list"]
+	if len(manifests) != 2 {
+		t.Fatalf("expected 2 manifest list entries, got %#v", manifests)
+	}
+}
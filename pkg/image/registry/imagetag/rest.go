@@ -0,0 +1,181 @@
+package imagetag
+
+import (
+	"fmt"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// imageStreamGetUpdater is the subset of the ImageStream registry that REST needs to read and
+// persist tag changes.
+type imageStreamGetUpdater interface {
+	GetImageStream(ctx kapi.Context, name string) (*imageapi.ImageStream, error)
+	UpdateImageStream(ctx kapi.Context, stream *imageapi.ImageStream) (*imageapi.ImageStream, error)
+}
+
+// imageGetter resolves the Image referenced by a tag's most recent TagEvent.
+type imageGetter interface {
+	GetImage(ctx kapi.Context, name string) (*imageapi.Image, error)
+}
+
+// REST implements GET/CREATE/DELETE for ImageTag, a combined view of a tag's spec, status,
+// and resolved image. A GET returns whichever of spec/status/image exist for the tag and is
+// only not-found when none do. A CREATE is only accepted when no spec tag exists yet and the
+// request sets only Spec. A DELETE clears both the spec and status entries for the tag.
+type REST struct {
+	streams imageStreamGetUpdater
+	images  imageGetter
+}
+
+// NewREST creates a new REST for ImageTag backed by the given ImageStream registry and Image getter.
+func NewREST(streams imageStreamGetUpdater, images imageGetter) *REST {
+	return &REST{streams: streams, images: images}
+}
+
+// New returns a new ImageTag for use with Create.
+func (r *REST) New() runtime.Object {
+	return &imageapi.ImageTag{}
+}
+
+// Get returns the combined spec/status/image view of name, which must be of the form
+// "<stream>:<tag>". It is only a not-found error when the stream carries no spec tag and no
+// status entry for tag.
+func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	streamName, tag, err := parseImageTagName(name)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := r.streams.GetImageStream(ctx, streamName)
+	if err != nil {
+		return nil, err
+	}
+
+	imageTag := &imageapi.ImageTag{ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: stream.Namespace}}
+	imageTag.Spec = findSpecTag(stream, tag)
+	imageTag.Status = findStatusTag(stream, tag)
+
+	if imageTag.Spec == nil && imageTag.Status == nil {
+		return nil, kapierrors.NewNotFound(Resource(), name)
+	}
+
+	if imageTag.Status != nil && len(imageTag.Status.Items) > 0 {
+		image, err := r.images.GetImage(ctx, imageTag.Status.Items[0].Image)
+		if err != nil && !kapierrors.IsNotFound(err) {
+			return nil, err
+		}
+		imageTag.Image = image
+	}
+
+	return imageTag, nil
+}
+
+// Create adds a spec tag to the backing ImageStream. It only succeeds when the stream has no
+// existing spec tag of this name and the request supplies only Spec (Status and Image are
+// populated by the server, never accepted from the caller).
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	imageTag, ok := obj.(*imageapi.ImageTag)
+	if !ok {
+		return nil, fmt.Errorf("obj is not an ImageTag: %#v", obj)
+	}
+	if imageTag.Status != nil || imageTag.Image != nil {
+		return nil, kapierrors.NewBadRequest("status and image may not be set on create, only spec")
+	}
+	if imageTag.Spec == nil {
+		return nil, kapierrors.NewBadRequest("spec is required")
+	}
+
+	streamName, tag, err := parseImageTagName(imageTag.Name)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := r.streams.GetImageStream(ctx, streamName)
+	if err != nil {
+		return nil, err
+	}
+	if findSpecTag(stream, tag) != nil {
+		return nil, kapierrors.NewAlreadyExists(Resource(), imageTag.Name)
+	}
+
+	spec := *imageTag.Spec
+	spec.Name = tag
+	stream.Spec.Tags = append(stream.Spec.Tags, spec)
+
+	if _, err := r.streams.UpdateImageStream(ctx, stream); err != nil {
+		return nil, err
+	}
+
+	imageTag.Spec = &spec
+	return imageTag, nil
+}
+
+// Delete removes both the spec and status entries for name from the backing ImageStream.
+func (r *REST) Delete(ctx kapi.Context, name string, options *kapi.DeleteOptions) (runtime.Object, error) {
+	streamName, tag, err := parseImageTagName(name)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := r.streams.GetImageStream(ctx, streamName)
+	if err != nil {
+		return nil, err
+	}
+
+	specTags := stream.Spec.Tags[:0]
+	for _, t := range stream.Spec.Tags {
+		if t.Name != tag {
+			specTags = append(specTags, t)
+		}
+	}
+	stream.Spec.Tags = specTags
+
+	statusTags := stream.Status.Tags[:0]
+	for _, t := range stream.Status.Tags {
+		if t.Tag != tag {
+			statusTags = append(statusTags, t)
+		}
+	}
+	stream.Status.Tags = statusTags
+
+	if _, err := r.streams.UpdateImageStream(ctx, stream); err != nil {
+		return nil, err
+	}
+	return &kapi.Status{Status: kapi.StatusSuccess}, nil
+}
+
+func findSpecTag(stream *imageapi.ImageStream, tag string) *imageapi.TagReference {
+	for i := range stream.Spec.Tags {
+		if stream.Spec.Tags[i].Name == tag {
+			ref := stream.Spec.Tags[i]
+			return &ref
+		}
+	}
+	return nil
+}
+
+func findStatusTag(stream *imageapi.ImageStream, tag string) *imageapi.NamedTagEventList {
+	for i := range stream.Status.Tags {
+		if stream.Status.Tags[i].Tag == tag {
+			list := stream.Status.Tags[i]
+			return &list
+		}
+	}
+	return nil
+}
+
+// parseImageTagName splits "<stream>:<tag>" into its two parts.
+func parseImageTagName(name string) (streamName, tag string, err error) {
+	parts := strings.SplitN(name, ":", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", kapierrors.NewBadRequest(fmt.Sprintf("%q must be of the form <stream>:<tag>", name))
+	}
+	return parts[0], parts[1], nil
+}
+
+// Resource identifies the resource this REST storage serves, for use in error construction.
+func Resource() string {
+	return "imagetags"
+}
@@ -0,0 +1,115 @@
+package imagetag
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+type fakeStreams struct {
+	stream  *imageapi.ImageStream
+	updated *imageapi.ImageStream
+}
+
+func (f *fakeStreams) GetImageStream(ctx kapi.Context, name string) (*imageapi.ImageStream, error) {
+	return f.stream, nil
+}
+
+func (f *fakeStreams) UpdateImageStream(ctx kapi.Context, stream *imageapi.ImageStream) (*imageapi.ImageStream, error) {
+	f.updated = stream
+	f.stream = stream
+	return stream, nil
+}
+
+type fakeImages struct{}
+
+func (fakeImages) GetImage(ctx kapi.Context, name string) (*imageapi.Image, error) {
+	return &imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: name}}, nil
+}
+
+func TestGetNotFoundWhenNeitherSpecNorStatus(t *testing.T) {
+	streams := &fakeStreams{stream: &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Name: "foo"}}}
+	r := NewREST(streams, fakeImages{})
+
+	if _, err := r.Get(kapi.NewContext(), "foo:missing"); !kapierrors.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestGetReturnsStatusOnlyTag(t *testing.T) {
+	streams := &fakeStreams{stream: &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo"},
+		Status: imageapi.ImageStreamStatus{
+			Tags: []imageapi.NamedTagEventList{{Tag: "latest", Items: []imageapi.TagEvent{{Image: "sha256:abc"}}}},
+		},
+	}}
+	r := NewREST(streams, fakeImages{})
+
+	obj, err := r.Get(kapi.NewContext(), "foo:latest")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tag := obj.(*imageapi.ImageTag)
+	if tag.Spec != nil {
+		t.Fatalf("expected no spec tag, got %#v", tag.Spec)
+	}
+	if tag.Status == nil || tag.Image == nil || tag.Image.Name != "sha256:abc" {
+		t.Fatalf("expected resolved status and image, got %#v", tag)
+	}
+}
+
+func TestCreateRejectsExistingSpecTag(t *testing.T) {
+	streams := &fakeStreams{stream: &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo"},
+		Spec:       imageapi.ImageStreamSpec{Tags: []imageapi.TagReference{{Name: "latest"}}},
+	}}
+	r := NewREST(streams, fakeImages{})
+
+	_, err := r.Create(kapi.NewContext(), &imageapi.ImageTag{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo:latest"},
+		Spec:       &imageapi.TagReference{},
+	})
+	if !kapierrors.IsAlreadyExists(err) {
+		t.Fatalf("expected already exists, got %v", err)
+	}
+}
+
+func TestCreateAddsSpecTag(t *testing.T) {
+	streams := &fakeStreams{stream: &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Name: "foo"}}}
+	r := NewREST(streams, fakeImages{})
+
+	_, err := r.Create(kapi.NewContext(), &imageapi.ImageTag{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo:latest"},
+		Spec:       &imageapi.TagReference{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(streams.stream.Spec.Tags) != 1 || streams.stream.Spec.Tags[0].Name != "latest" {
+		t.Fatalf("expected spec tag %q to be added, got %#v", "latest", streams.stream.Spec.Tags)
+	}
+}
+
+func TestDeleteClearsSpecAndStatus(t *testing.T) {
+	streams := &fakeStreams{stream: &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo"},
+		Spec:       imageapi.ImageStreamSpec{Tags: []imageapi.TagReference{{Name: "latest"}}},
+		Status: imageapi.ImageStreamStatus{
+			Tags: []imageapi.NamedTagEventList{{Tag: "latest", Items: []imageapi.TagEvent{{Image: "sha256:abc"}}}},
+		},
+	}}
+	r := NewREST(streams, fakeImages{})
+
+	if _, err := r.Delete(kapi.NewContext(), "foo:latest", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(streams.stream.Spec.Tags) != 0 {
+		t.Fatalf("expected spec tag to be cleared, got %#v", streams.stream.Spec.Tags)
+	}
+	if len(streams.stream.Status.Tags) != 0 {
+		t.Fatalf("expected status tag to be cleared, got %#v", streams.stream.Status.Tags)
+	}
+}